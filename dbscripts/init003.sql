@@ -0,0 +1,9 @@
+CREATE TABLE messages (
+    id bigint primary key generated always as identity,
+    created_at timestamptz default NOW() NOT NULL,
+    short_code varchar(255) NOT NULL,
+    sender varchar(255) NOT NULL,
+    body text NOT NULL
+);
+
+CREATE INDEX messages_short_code_id_idx ON messages (short_code, id DESC);
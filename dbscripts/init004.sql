@@ -0,0 +1,11 @@
+CREATE TABLE rooms (
+    short_code varchar(255) primary key,
+    created_at timestamptz default NOW() NOT NULL,
+    name varchar(255) NOT NULL,
+    password varchar(255) NOT NULL,
+    owner varchar(255) NOT NULL
+);
+
+DELETE FROM messages WHERE short_code NOT IN (SELECT short_code FROM rooms);
+
+ALTER TABLE messages ADD CONSTRAINT messages_short_code_fkey FOREIGN KEY (short_code) REFERENCES rooms(short_code) ON DELETE CASCADE;
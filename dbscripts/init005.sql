@@ -0,0 +1,2 @@
+ALTER TABLE messages ADD COLUMN message_id uuid unique NOT NULL default gen_random_uuid();
+ALTER TABLE messages ADD COLUMN sequence bigint NOT NULL default 0;
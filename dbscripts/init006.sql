@@ -0,0 +1 @@
+ALTER TABLE rooms ADD COLUMN slow_consumer_policy varchar(255) NOT NULL default 'DROP_OLDEST';
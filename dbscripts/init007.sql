@@ -0,0 +1,3 @@
+ALTER TABLE rooms ADD COLUMN last_sequence bigint NOT NULL default 0;
+
+UPDATE rooms SET last_sequence = coalesce((SELECT max(sequence) FROM messages WHERE messages.short_code = rooms.short_code), 0);
@@ -0,0 +1 @@
+ALTER TABLE messages ADD COLUMN recipient varchar(255) NOT NULL default '';
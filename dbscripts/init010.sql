@@ -0,0 +1,12 @@
+ALTER TABLE messages ADD COLUMN reply_to varchar(255) NOT NULL default '';
+
+CREATE TABLE reactions (
+    message_id uuid NOT NULL REFERENCES messages(message_id) ON DELETE CASCADE,
+    short_code varchar(255) NOT NULL REFERENCES rooms(short_code) ON DELETE CASCADE,
+    user_name varchar(255) NOT NULL,
+    emoji varchar(255) NOT NULL,
+    created_at timestamptz default NOW() NOT NULL,
+    primary key (message_id, user_name, emoji)
+);
+
+CREATE INDEX reactions_short_code_idx ON reactions (short_code);
@@ -0,0 +1,9 @@
+CREATE TABLE read_cursors (
+    short_code varchar(255) NOT NULL REFERENCES rooms(short_code) ON DELETE CASCADE,
+    user_name varchar(255) NOT NULL,
+    last_read_sequence bigint NOT NULL default 0,
+    updated_at timestamptz default NOW() NOT NULL,
+    primary key (short_code, user_name)
+);
+
+CREATE INDEX read_cursors_user_name_idx ON read_cursors (user_name);
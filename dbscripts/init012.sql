@@ -0,0 +1 @@
+ALTER TABLE rooms ADD COLUMN public boolean NOT NULL default false;
@@ -0,0 +1 @@
+ALTER TABLE rooms ADD COLUMN description text NOT NULL default '';
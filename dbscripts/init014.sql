@@ -0,0 +1,6 @@
+CREATE TABLE bans (
+    short_code varchar(255) NOT NULL REFERENCES rooms(short_code) ON DELETE CASCADE,
+    user_name varchar(255) NOT NULL,
+    created_at timestamptz default NOW() NOT NULL,
+    primary key (short_code, user_name)
+);
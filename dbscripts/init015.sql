@@ -0,0 +1 @@
+ALTER TABLE read_cursors ADD COLUMN role varchar(255) NOT NULL default 'MEMBER';
@@ -0,0 +1 @@
+ALTER TABLE rooms ADD COLUMN expires_at timestamptz;
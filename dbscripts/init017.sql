@@ -0,0 +1,7 @@
+CREATE TABLE chat_token_revocations (
+    short_code varchar(255) NOT NULL,
+    user_name varchar(255) NOT NULL default '',
+    revoked_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (short_code, user_name)
+);
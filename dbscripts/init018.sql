@@ -0,0 +1,12 @@
+CREATE TABLE refresh_tokens (
+    id bigint primary key generated always as identity,
+    family_id uuid NOT NULL,
+    user_id bigint NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash varchar(255) unique NOT NULL,
+    created_at timestamptz default NOW() NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used boolean NOT NULL default false,
+    revoked boolean NOT NULL default false
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
@@ -0,0 +1,8 @@
+CREATE TABLE user_identities (
+    id bigint primary key generated always as identity,
+    user_id bigint NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    issuer varchar(255) NOT NULL,
+    subject varchar(255) NOT NULL,
+    created_at timestamptz default NOW() NOT NULL,
+    UNIQUE (issuer, subject)
+);
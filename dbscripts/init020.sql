@@ -0,0 +1,12 @@
+CREATE TABLE room_members (
+    short_code varchar(255) NOT NULL REFERENCES rooms(short_code) ON DELETE CASCADE,
+    user_name varchar(255) NOT NULL,
+    role varchar(255) NOT NULL default 'MEMBER',
+    created_at timestamptz default NOW() NOT NULL,
+    primary key (short_code, user_name)
+);
+
+INSERT INTO room_members (short_code, user_name, role, created_at)
+SELECT short_code, user_name, role, updated_at FROM read_cursors;
+
+ALTER TABLE read_cursors DROP COLUMN role;
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/MSSkowron/GRPCChatter/pkg/client"
 	"golang.org/x/term"
@@ -31,7 +32,14 @@ func main() {
 	}
 	grpcServerAddress = strings.Trim(grpcServerAddress, "\r\n")
 
-	c := client.NewClient(restServerAddress, grpcServerAddress)
+	c := client.NewClient(restServerAddress, grpcServerAddress,
+		client.WithReconnectPolicy(client.DefaultReconnectPolicy),
+		client.WithConnectionStateHandler(func(state client.ConnectionState) {
+			if state == client.StateReconnecting {
+				log.Println("Lost connection with the server, reconnecting...")
+			}
+		}),
+	)
 	defer c.Disconnect()
 
 	fmt.Printf("\n")
@@ -42,7 +50,8 @@ func main() {
 		fmt.Println("3. Create chat room")
 		fmt.Println("4. Delete chat room")
 		fmt.Println("5. Join chat room")
-		fmt.Println("6. Quit")
+		fmt.Println("6. List public chat rooms")
+		fmt.Println("7. Quit")
 		fmt.Print("> ")
 
 		choice, err := reader.ReadString('\n')
@@ -106,7 +115,18 @@ func main() {
 				log.Fatalf("Failed to read password: %s\n", err)
 			}
 
-			shortCode, err := c.CreateChatRoom(name, password)
+			fmt.Print("Public? [y/N]: ")
+			public, err := reader.ReadString('\n')
+			if err != nil {
+				log.Fatalf("Failed to read chat room visibility: %s\n", err)
+			}
+
+			var opts []client.RoomOpt
+			if strings.EqualFold(strings.Trim(public, "\r\n"), "y") {
+				opts = append(opts, client.WithPublic())
+			}
+
+			shortCode, err := c.CreateChatRoom(name, password, opts...)
 			if err != nil {
 				log.Printf("\nFailed to create chat room: %s\n", err)
 				continue
@@ -146,7 +166,7 @@ func main() {
 				continue
 			}
 
-			fmt.Printf("\nJoined chat room.\n")
+			fmt.Printf("\nJoined chat room. Type /msg <user> <message> to send a private message.\n")
 
 			wg := &sync.WaitGroup{}
 			wg.Add(2)
@@ -162,6 +182,24 @@ func main() {
 			close(receiveCh)
 			close(sendCh)
 		case "6":
+			fmt.Print("Enter name filter: ")
+			nameFilter, err := reader.ReadString('\n')
+			if err != nil {
+				log.Fatalf("Failed to read name filter: %s\n", err)
+			}
+			nameFilter = strings.Trim(nameFilter, "\r\n")
+
+			rooms, _, err := c.ListChatRooms(nameFilter, "", 0)
+			if err != nil {
+				log.Printf("\nFailed to list chat rooms: %s\n", err)
+				continue
+			}
+
+			fmt.Printf("\nPublic chat rooms:\n")
+			for _, room := range rooms {
+				fmt.Printf("%s: %s (owner: %s, members: %d)\n", room.ShortCode, room.Name, room.Owner, room.MemberCount)
+			}
+		case "7":
 			fmt.Printf("\nGoodbye!\n")
 			return
 		default:
@@ -178,8 +216,21 @@ func receiveAndPrintMessages(c *client.Client, sendStopCh chan<- struct{}, recei
 		case <-receiveStopCh:
 			return
 		default:
-			msg, err := c.Receive()
+			event, err := c.Receive()
 			if err != nil {
+				if errors.Is(err, client.ErrRecipientNotFound) || errors.Is(err, client.ErrInvalidRecipient) {
+					log.Printf("Failed to send private message: %s\n", err)
+					continue
+				}
+				if errors.Is(err, client.ErrMessageNotFound) || errors.Is(err, client.ErrInvalidReaction) {
+					log.Printf("Failed to reply or react to message: %s\n", err)
+					continue
+				}
+				if errors.Is(err, client.ErrInvalidReadSequence) {
+					log.Printf("Failed to mark message as read: %s\n", err)
+					continue
+				}
+
 				if errors.Is(err, client.ErrConnectionClosed) || errors.Is(err, client.ErrConnectionNotExist) || errors.Is(err, client.ErrStreamNotExist) {
 					log.Println("Failed to receive message: lost connection with the server")
 				} else {
@@ -191,7 +242,55 @@ func receiveAndPrintMessages(c *client.Client, sendStopCh chan<- struct{}, recei
 				return
 			}
 
-			fmt.Printf("[%s]: %s\n", msg.Sender, msg.Body)
+			switch event := event.(type) {
+			case client.Message:
+				if event.ReplyTo != "" {
+					fmt.Printf("[%s] [%s] in reply to %s: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.Sender, event.ReplyTo, event.Body)
+				} else if event.Private {
+					fmt.Printf("[%s] [%s -> you]: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.Sender, event.Body)
+				} else {
+					fmt.Printf("[%s] [%s]: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.Sender, event.Body)
+				}
+
+				// Acknowledging the displayed message lets other users know it has been read.
+				if err := c.MarkRead(event.Sequence); err != nil {
+					log.Printf("Failed to mark message as read: %s\n", err)
+				}
+			case client.MessageEditedEvent:
+				fmt.Printf("[%s] Message %s has been edited: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.MessageID, event.Body)
+			case client.MessageDeletedEvent:
+				fmt.Printf("[%s] Message %s has been deleted\n", event.Timestamp.Local().Format(time.TimeOnly), event.MessageID)
+			case client.ReactionChangedEvent:
+				fmt.Printf("[%s] Message %s has %d %s reactions\n", event.Timestamp.Local().Format(time.TimeOnly), event.MessageID, event.Count, event.Emoji)
+			case client.UserJoinedEvent:
+				fmt.Printf("[%s] %s joined the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName)
+			case client.UserLeftEvent:
+				fmt.Printf("[%s] %s left the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName)
+			case client.UserKickedEvent:
+				fmt.Printf("[%s] %s has been kicked from the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName)
+			case client.UserBannedEvent:
+				fmt.Printf("[%s] %s has been banned from the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName)
+			case client.RoleChangedEvent:
+				fmt.Printf("[%s] %s is now %s in the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName, event.Role)
+			case client.OwnerChangedEvent:
+				fmt.Printf("[%s] %s is now the owner of the chat room\n", event.Timestamp.Local().Format(time.TimeOnly), event.UserName)
+			case client.TypingEvent:
+				if event.Typing {
+					fmt.Printf("%s is typing...\n", event.UserName)
+				}
+			case client.MissedMessagesEvent:
+				log.Printf("Missed %d messages\n", event.Count)
+			case client.AnnouncementEvent:
+				fmt.Printf("[%s] Announcement from %s: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.Sender, event.Body)
+			case client.RoomUpdatedEvent:
+				fmt.Printf("[%s] The chat room is now %s: %s\n", event.Timestamp.Local().Format(time.TimeOnly), event.Name, event.Description)
+			case client.RoomDeletedEvent:
+				log.Println("The chat room has been deleted")
+
+				sendStopCh <- struct{}{}
+
+				return
+			}
 		}
 	}
 }
@@ -215,7 +314,7 @@ func readAndSendMessages(c *client.Client, sendStopCh chan<- struct{}, receiveSt
 			}
 			msg = strings.Trim(msg, "\r\n")
 
-			if err := c.Send(msg); err != nil {
+			if err := sendMessage(c, msg); err != nil {
 				if errors.Is(err, client.ErrConnectionClosed) || errors.Is(err, client.ErrConnectionNotExist) || errors.Is(err, client.ErrStreamNotExist) {
 					log.Println("Failed to send message: lost connection with the server")
 				} else {
@@ -230,6 +329,17 @@ func readAndSendMessages(c *client.Client, sendStopCh chan<- struct{}, receiveSt
 	}
 }
 
+// sendMessage sends the message to all users in the chat room, or privately if it has the form "/msg <user> <message>".
+func sendMessage(c *client.Client, msg string) error {
+	if rest, ok := strings.CutPrefix(msg, "/msg "); ok {
+		if user, body, ok := strings.Cut(rest, " "); ok {
+			return c.SendTo(user, body)
+		}
+	}
+
+	return c.Send(msg)
+}
+
 func readPassword() (string, error) {
 	passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
 	if err != nil {
@@ -2,21 +2,37 @@ package app
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"fmt"
+	"net/http"
 
+	"github.com/MSSkowron/GRPCChatter/internal/broker"
 	"github.com/MSSkowron/GRPCChatter/internal/config"
 	"github.com/MSSkowron/GRPCChatter/internal/database"
 	"github.com/MSSkowron/GRPCChatter/internal/repository"
+	"github.com/MSSkowron/GRPCChatter/internal/revocation"
 	"github.com/MSSkowron/GRPCChatter/internal/server/grpc"
 	"github.com/MSSkowron/GRPCChatter/internal/server/rest"
 	"github.com/MSSkowron/GRPCChatter/internal/service"
 	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/MSSkowron/GRPCChatter/pkg/oidc"
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
 	"golang.org/x/sync/errgroup"
 )
 
 const (
 	defaultConfigFilePath = "./configs/default_config.env"
+
+	brokerInProcess = "in-process"
+	brokerPostgres  = "postgres"
+
+	revocationStoreInMemory = "in-memory"
+	revocationStorePostgres = "postgres"
+
+	defaultTokenIssuer       = "grpcchatter"
+	defaultUserTokenAudience = "grpcchatter-user"
+	defaultChatTokenAudience = "grpcchatter-chat"
 )
 
 // Run runs the GRPCChatter application.
@@ -36,12 +52,61 @@ func Run() error {
 	defer database.Close()
 
 	userRepository := repository.NewUserRepository(database)
+	roomRepository := repository.NewRoomRepository(database)
+	messageRepository := repository.NewMessageRepository(database)
+	reactionRepository := repository.NewReactionRepository(database)
+	readCursorRepository := repository.NewReadCursorRepository(database)
+	roomMemberRepository := repository.NewRoomMemberRepository(database)
+	banRepository := repository.NewBanRepository(database)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(database)
+
+	// Each token type can be signed with its own keys, falling back to the ones shared by both types.
+	userTokenKeySet, err := newKeySet(firstNonEmpty(config.UserTokenSigningKeysDir, config.SigningKeysDir), firstNonEmpty(config.UserTokenSigningKeyID, config.SigningKeyID), firstNonEmpty(config.UserTokenSecret, config.Secret))
+	if err != nil {
+		return err
+	}
+	chatTokenKeySet, err := newKeySet(firstNonEmpty(config.ChatTokenSigningKeysDir, config.SigningKeysDir), firstNonEmpty(config.ChatTokenSigningKeyID, config.SigningKeyID), firstNonEmpty(config.ChatTokenSecret, config.Secret))
+	if err != nil {
+		return err
+	}
+	// Both key sets are published by the JWKS endpoint, where their keys must be told apart by their IDs.
+	if _, err := token.NewJWKS(userTokenKeySet, chatTokenKeySet); err != nil {
+		return fmt.Errorf("failed to publish signing keys: %w", err)
+	}
+	tokenIssuer := firstNonEmpty(config.TokenIssuer, defaultTokenIssuer)
+	userTokenIssuer := token.NewIssuer(tokenIssuer, firstNonEmpty(config.UserTokenAudience, defaultUserTokenAudience), userTokenKeySet)
+	chatTokenIssuer := token.NewIssuer(tokenIssuer, firstNonEmpty(config.ChatTokenAudience, defaultChatTokenAudience), chatTokenKeySet)
 
-	userTokenService := service.NewUserTokenService(config.Secret, config.TokenDuration)
-	userService := service.NewUserService(userTokenService, userRepository)
-	chatTokenService := service.NewChatTokenService(config.Secret)
+	userTokenService := service.NewUserTokenService(userTokenIssuer, config.TokenDuration)
+	userService := service.NewUserService(userTokenService, userRepository, refreshTokenRepository, config.RefreshTokenDuration)
+	revocationStore, err := newRevocationStore(config.TokenRevocationStore, database)
+	if err != nil {
+		return err
+	}
+	chatTokenService := service.NewChatTokenService(chatTokenIssuer, config.ChatTokenDuration, revocationStore)
 	shortCodeService := service.NewShortCodeService(config.ShortCodeLength)
-	roomService := service.NewRoomService(config.MaxMessageQueueSize)
+	slowConsumerPolicy, err := service.ParseSlowConsumerPolicy(config.DefaultSlowConsumerPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to parse default slow consumer policy: %w", err)
+	}
+	ownerSuccessionPolicy, err := service.ParseOwnerSuccessionPolicy(config.OwnerSuccessionPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to parse owner succession policy: %w", err)
+	}
+	eventBroker, err := newBroker(config.Broker, database)
+	if err != nil {
+		return err
+	}
+	defer eventBroker.Close()
+
+	roomService, err := service.NewRoomService(config.MaxMessageQueueSize, config.ReplayBufferSize, slowConsumerPolicy, eventBroker, roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository, service.WithOwnerSuccession(ownerSuccessionPolicy, config.OwnerSuccessionGracePeriod), service.WithRoomReaper(config.RoomReaperInterval, config.RoomIdleTimeout))
+	if err != nil {
+		return fmt.Errorf("failed to create room service: %w", err)
+	}
+
+	expvar.Publish("slow_consumer", expvar.Func(func() any {
+		return roomService.GetSlowConsumerMetrics()
+	}))
 
 	grpcServer := grpc.NewServer(
 		chatTokenService,
@@ -52,13 +117,36 @@ func Run() error {
 		grpc.WithPort(config.GRPCServerPort),
 	)
 
-	restServer := rest.NewServer(
-		userService,
+	restServerOpts := []rest.ServerOption{
 		rest.WithAddress(fmt.Sprintf("%s:%d", config.RESTServerAddress, config.RESTServerPort)),
-	)
+		rest.WithKeySets(userTokenKeySet, chatTokenKeySet),
+	}
+	if config.OIDCIssuerURL != "" {
+		oidcProvider, err := oidc.Discover(context.Background(), config.OIDCIssuerURL, http.DefaultClient)
+		if err != nil {
+			return fmt.Errorf("failed to configure OIDC login: %w", err)
+		}
+
+		restServerOpts = append(restServerOpts, rest.WithOIDCClient(oidc.NewClient(oidcProvider, config.OIDCClientID, config.OIDCClientSecret, config.OIDCRedirectURL)))
+	}
+	restServer := rest.NewServer(userService, restServerOpts...)
 
 	g := errgroup.Group{}
 
+	// Metrics are served on a separate listener, so that they are not exposed to the clients of the public servers.
+	if config.MetricsServerPort != 0 {
+		metricsServer := newMetricsServer(fmt.Sprintf("%s:%d", config.MetricsServerAddress, config.MetricsServerPort))
+
+		g.Go(func() error {
+			logger.Info(fmt.Sprintf("Metrics Server listening on %s:%d", config.MetricsServerAddress, config.MetricsServerPort))
+
+			if err := metricsServer.ListenAndServe(); err != nil {
+				return fmt.Errorf("failed to run metrics server: %w", err)
+			}
+			return nil
+		})
+	}
+
 	g.Go(func() error {
 		logger.Info(fmt.Sprintf("REST Server listening on %s:%d", config.RESTServerAddress, config.RESTServerPort))
 
@@ -79,3 +167,61 @@ func Run() error {
 
 	return g.Wait()
 }
+
+func newMetricsServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return &http.Server{
+		Addr:         addr,
+		Handler:      mux,
+		ReadTimeout:  rest.DefaultReadTimeout,
+		WriteTimeout: rest.DefaultWriteTimeout,
+	}
+}
+
+func newBroker(kind string, database *database.PostgresDatabase) (broker.Broker, error) {
+	switch kind {
+	case brokerInProcess, "":
+		return broker.NewInProcessBroker(), nil
+	case brokerPostgres:
+		return broker.NewPostgresBroker(database, database), nil
+	default:
+		return nil, fmt.Errorf("unknown broker: %s", kind)
+	}
+}
+
+func newRevocationStore(kind string, database *database.PostgresDatabase) (revocation.Store, error) {
+	switch kind {
+	case revocationStoreInMemory, "":
+		return revocation.NewInMemoryStore(), nil
+	case revocationStorePostgres:
+		return revocation.NewPostgresStore(database), nil
+	default:
+		return nil, fmt.Errorf("unknown token revocation store: %s", kind)
+	}
+}
+
+func newKeySet(dir, signingKeyID, secret string) (*token.KeySet, error) {
+	if dir == "" {
+		return token.NewHMACKeySet(secret), nil
+	}
+
+	keySet, err := token.LoadKeySet(dir, signingKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing keys: %w", err)
+	}
+
+	return keySet, nil
+}
+
+// firstNonEmpty returns the first of the values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
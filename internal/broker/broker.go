@@ -0,0 +1,86 @@
+package broker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+var (
+	// ErrBrokerClosed is returned when the broker has been closed.
+	ErrBrokerClosed = errors.New("broker closed")
+	// ErrEventTooLarge is returned when the encoded event exceeds the size supported by the broker.
+	ErrEventTooLarge = errors.New("event too large")
+)
+
+// EventType is the type of a chat room event.
+type EventType string
+
+const (
+	// EventTypeMessage means a message has been broadcast in the chat room.
+	EventTypeMessage EventType = "MESSAGE"
+	// EventTypeMessageEdited means a message broadcast in the chat room has been edited.
+	EventTypeMessageEdited EventType = "MESSAGE_EDITED"
+	// EventTypeMessageDeleted means a message broadcast in the chat room has been deleted.
+	EventTypeMessageDeleted EventType = "MESSAGE_DELETED"
+	// EventTypeReactionAdded means the user has reacted to a message broadcast in the chat room.
+	EventTypeReactionAdded EventType = "REACTION_ADDED"
+	// EventTypeReactionRemoved means the user has withdrawn their reaction to a message broadcast in the chat room.
+	EventTypeReactionRemoved EventType = "REACTION_REMOVED"
+	// EventTypeMessagesRead means the user has read the messages of the chat room up to a sequence number.
+	EventTypeMessagesRead EventType = "MESSAGES_READ"
+	// EventTypeRoomCreated means the chat room has been created.
+	EventTypeRoomCreated EventType = "ROOM_CREATED"
+	// EventTypeRoomUpdated means the chat room has been updated.
+	EventTypeRoomUpdated EventType = "ROOM_UPDATED"
+	// EventTypeRoomDeleted means the chat room has been deleted.
+	EventTypeRoomDeleted EventType = "ROOM_DELETED"
+	// EventTypeUserJoined means the user has joined the chat room through the instance.
+	EventTypeUserJoined EventType = "USER_JOINED"
+	// EventTypeUserLeft means the user has left the chat room through the instance.
+	EventTypeUserLeft EventType = "USER_LEFT"
+	// EventTypeUserKicked means the user has been kicked from the chat room.
+	EventTypeUserKicked EventType = "USER_KICKED"
+	// EventTypeUserBanned means the user has been banned from the chat room.
+	EventTypeUserBanned EventType = "USER_BANNED"
+	// EventTypeUserUnbanned means the user has been unbanned from the chat room.
+	EventTypeUserUnbanned EventType = "USER_UNBANNED"
+	// EventTypeRoleChanged means the role of the user in the chat room has been changed.
+	EventTypeRoleChanged EventType = "ROLE_CHANGED"
+	// EventTypeOwnerChanged means the ownership of the chat room has been transferred to the user.
+	EventTypeOwnerChanged EventType = "OWNER_CHANGED"
+	// EventTypeAnnouncement means a message has been announced to all users in all chat rooms.
+	EventTypeAnnouncement EventType = "ANNOUNCEMENT"
+	// EventTypeTyping means the user has started or stopped typing in the chat room.
+	EventTypeTyping EventType = "TYPING"
+	// EventTypeInstanceStarted means the instance has started and asks the other instances to announce their users.
+	EventTypeInstanceStarted EventType = "INSTANCE_STARTED"
+)
+
+// Event represents a change in a chat room that is distributed to all application instances.
+type Event struct {
+	Type      EventType      `json:"type"`
+	Instance  string         `json:"instance,omitempty"`
+	ShortCode string         `json:"short_code,omitempty"`
+	UserName  string         `json:"user_name,omitempty"`
+	Message   *model.Message `json:"message,omitempty"`
+	Typing    bool           `json:"typing,omitempty"`
+	Emoji     string         `json:"emoji,omitempty"`
+	Sequence  uint64         `json:"sequence,omitempty"`
+	Role      string         `json:"role,omitempty"`
+}
+
+// Broker is an interface that defines the methods required for distributing chat room events between application instances.
+type Broker interface {
+	// Publish sends the event to all subscribers, including the ones of the publishing instance.
+	// Events published by a single instance are received in the order they were published.
+	Publish(ctx context.Context, event *Event) error
+
+	// Subscribe starts receiving all published events until the context is done or the broker is closed.
+	// The returned channel is closed once the subscription ends.
+	Subscribe(ctx context.Context) (<-chan *Event, error)
+
+	// Close ends all subscriptions and releases resources.
+	Close() error
+}
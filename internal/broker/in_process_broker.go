@@ -0,0 +1,138 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// InProcessBroker implements the Broker interface for a single application instance.
+// Publishing never blocks; events are queued for each subscriber without limit.
+type InProcessBroker struct {
+	mu            sync.RWMutex
+	subscriptions map[*inProcessSubscription]struct{}
+	closed        bool
+}
+
+type inProcessSubscription struct {
+	mu      sync.Mutex
+	pending []*Event
+	wakeCh  chan struct{}
+	doneCh  chan struct{}
+	events  chan *Event
+}
+
+// NewInProcessBroker creates a new InProcessBroker instance.
+func NewInProcessBroker() *InProcessBroker {
+	return &InProcessBroker{
+		subscriptions: make(map[*inProcessSubscription]struct{}),
+	}
+}
+
+func (ipb *InProcessBroker) Publish(ctx context.Context, event *Event) error {
+	ipb.mu.RLock()
+	defer ipb.mu.RUnlock()
+
+	if ipb.closed {
+		return ErrBrokerClosed
+	}
+
+	for subscription := range ipb.subscriptions {
+		subscription.push(event)
+	}
+
+	return nil
+}
+
+func (ipb *InProcessBroker) Subscribe(ctx context.Context) (<-chan *Event, error) {
+	ipb.mu.Lock()
+	defer ipb.mu.Unlock()
+
+	if ipb.closed {
+		return nil, ErrBrokerClosed
+	}
+
+	subscription := &inProcessSubscription{
+		wakeCh: make(chan struct{}, 1),
+		doneCh: make(chan struct{}),
+		events: make(chan *Event),
+	}
+	ipb.subscriptions[subscription] = struct{}{}
+
+	go subscription.run()
+
+	context.AfterFunc(ctx, func() {
+		ipb.unsubscribe(subscription)
+	})
+
+	return subscription.events, nil
+}
+
+func (ipb *InProcessBroker) Close() error {
+	ipb.mu.Lock()
+	defer ipb.mu.Unlock()
+
+	if ipb.closed {
+		return nil
+	}
+
+	for subscription := range ipb.subscriptions {
+		close(subscription.doneCh)
+	}
+	ipb.subscriptions = nil
+	ipb.closed = true
+
+	return nil
+}
+
+func (ipb *InProcessBroker) unsubscribe(subscription *inProcessSubscription) {
+	ipb.mu.Lock()
+	defer ipb.mu.Unlock()
+
+	if _, ok := ipb.subscriptions[subscription]; !ok {
+		return
+	}
+
+	close(subscription.doneCh)
+	delete(ipb.subscriptions, subscription)
+}
+
+// push queues the event for the subscriber.
+func (ips *inProcessSubscription) push(event *Event) {
+	ips.mu.Lock()
+	ips.pending = append(ips.pending, event)
+	ips.mu.Unlock()
+
+	select {
+	case ips.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// run forwards queued events to the subscriber until the subscription ends.
+func (ips *inProcessSubscription) run() {
+	defer close(ips.events)
+
+	for {
+		ips.mu.Lock()
+		pending := ips.pending
+		ips.pending = nil
+		ips.mu.Unlock()
+
+		if len(pending) == 0 {
+			select {
+			case <-ips.wakeCh:
+				continue
+			case <-ips.doneCh:
+				return
+			}
+		}
+
+		for _, event := range pending {
+			select {
+			case ips.events <- event:
+			case <-ips.doneCh:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessBrokerPublishSubscribe(t *testing.T) {
+	broker := NewInProcessBroker()
+	defer broker.Close()
+
+	first, err := broker.Subscribe(context.Background())
+	require.NoError(t, err)
+	second, err := broker.Subscribe(context.Background())
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, broker.Publish(context.Background(), &Event{
+			Type:      EventTypeMessage,
+			ShortCode: "ABC123",
+			Message:   &model.Message{Sequence: uint64(i)},
+		}))
+	}
+
+	for _, events := range []<-chan *Event{first, second} {
+		for i := 1; i <= 3; i++ {
+			event := <-events
+			require.Equal(t, EventTypeMessage, event.Type)
+			require.Equal(t, uint64(i), event.Message.Sequence)
+		}
+	}
+}
+
+func TestInProcessBrokerUnsubscribe(t *testing.T) {
+	broker := NewInProcessBroker()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled, err := broker.Subscribe(ctx)
+	require.NoError(t, err)
+	closed, err := broker.Subscribe(context.Background())
+	require.NoError(t, err)
+
+	cancel()
+	_, ok := <-cancelled
+	require.False(t, ok)
+
+	require.NoError(t, broker.Close())
+	_, ok = <-closed
+	require.False(t, ok)
+
+	require.ErrorIs(t, broker.Publish(context.Background(), &Event{Type: EventTypeRoomDeleted}), ErrBrokerClosed)
+	_, err = broker.Subscribe(context.Background())
+	require.ErrorIs(t, err, ErrBrokerClosed)
+}
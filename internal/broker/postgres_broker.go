@@ -0,0 +1,102 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+)
+
+const (
+	// postgresChannel is the name of the PostgreSQL notification channel the events are sent on.
+	postgresChannel = "grpcchatter_events"
+	// maxPostgresPayloadSize is the maximum size of a PostgreSQL notification payload in bytes.
+	maxPostgresPayloadSize = 7999
+)
+
+// PostgresBroker implements the Broker interface using PostgreSQL LISTEN and NOTIFY, so that multiple application instances sharing a database receive each other's events.
+// Events are encoded as JSON, which must not exceed the PostgreSQL notification payload limit of 8000 bytes.
+type PostgresBroker struct {
+	db       database.Database
+	listener database.Listener
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// NewPostgresBroker creates a new PostgresBroker instance with the provided database used for publishing and listener used for subscribing.
+func NewPostgresBroker(db database.Database, listener database.Listener) *PostgresBroker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &PostgresBroker{
+		db:       db,
+		listener: listener,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (pb *PostgresBroker) Publish(ctx context.Context, event *Event) error {
+	if pb.ctx.Err() != nil {
+		return ErrBrokerClosed
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	if len(payload) > maxPostgresPayloadSize {
+		return ErrEventTooLarge
+	}
+
+	if _, err := pb.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", postgresChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+func (pb *PostgresBroker) Subscribe(ctx context.Context) (<-chan *Event, error) {
+	if pb.ctx.Err() != nil {
+		return nil, ErrBrokerClosed
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	context.AfterFunc(pb.ctx, cancel)
+
+	payloads, err := pb.listener.Listen(ctx, postgresChannel)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	events := make(chan *Event)
+
+	go func() {
+		defer close(events)
+		defer cancel()
+
+		for payload := range payloads {
+			event := &Event{}
+			if err := json.Unmarshal([]byte(payload), event); err != nil {
+				logger.Error(fmt.Sprintf("Failed to decode event [%s]: %s", payload, err))
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (pb *PostgresBroker) Close() error {
+	pb.cancel()
+	return nil
+}
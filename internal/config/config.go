@@ -20,14 +20,73 @@ type Config struct {
 	RESTServerAddress string `mapstructure:"REST_SERVER_ADDRESS"`
 	// RESTServerPort is the port on which the REST server will listen.
 	RESTServerPort int `mapstructure:"REST_SERVER_PORT"`
-	// Secret is a secret key used for JWT token signing and validation.
+	// MetricsServerAddress is the IP address where the internal metrics server will listen.
+	MetricsServerAddress string `mapstructure:"METRICS_SERVER_ADDRESS"`
+	// MetricsServerPort is the port on which the internal metrics server will listen. The metrics server is disabled if it is 0.
+	MetricsServerPort int `mapstructure:"METRICS_SERVER_PORT"`
+	// Secret is a secret key used for JWT token signing and validation, unless SigningKeysDir is set.
 	Secret string `mapstructure:"SECRET"`
+	// SigningKeysDir is the directory with the PEM-encoded RSA and Ed25519 keys used for JWT token signing and validation instead of the secret.
+	// Each key is identified by the name of its file without the .pem extension.
+	SigningKeysDir string `mapstructure:"SIGNING_KEYS_DIR"`
+	// SigningKeyID is the ID of the private key in SigningKeysDir that signs new JWT tokens. The other keys only validate tokens.
+	SigningKeyID string `mapstructure:"SIGNING_KEY_ID"`
+	// TokenIssuer is the name of the issuer of JWT tokens, carried in their iss claim.
+	TokenIssuer string `mapstructure:"TOKEN_ISSUER"`
+	// UserTokenAudience is the audience of user tokens, carried in their aud claim.
+	UserTokenAudience string `mapstructure:"USER_TOKEN_AUDIENCE"`
+	// UserTokenSecret overrides Secret for user tokens.
+	UserTokenSecret string `mapstructure:"USER_TOKEN_SECRET"`
+	// UserTokenSigningKeysDir overrides SigningKeysDir for user tokens.
+	UserTokenSigningKeysDir string `mapstructure:"USER_TOKEN_SIGNING_KEYS_DIR"`
+	// UserTokenSigningKeyID overrides SigningKeyID for user tokens.
+	UserTokenSigningKeyID string `mapstructure:"USER_TOKEN_SIGNING_KEY_ID"`
+	// ChatTokenAudience is the audience of chat tokens, carried in their aud claim.
+	ChatTokenAudience string `mapstructure:"CHAT_TOKEN_AUDIENCE"`
+	// ChatTokenSecret overrides Secret for chat tokens.
+	ChatTokenSecret string `mapstructure:"CHAT_TOKEN_SECRET"`
+	// ChatTokenSigningKeysDir overrides SigningKeysDir for chat tokens.
+	ChatTokenSigningKeysDir string `mapstructure:"CHAT_TOKEN_SIGNING_KEYS_DIR"`
+	// ChatTokenSigningKeyID overrides SigningKeyID for chat tokens.
+	ChatTokenSigningKeyID string `mapstructure:"CHAT_TOKEN_SIGNING_KEY_ID"`
+	// OIDCIssuerURL is the issuer URL of the OpenID Provider users can sign in with. Signing in with it is disabled if it is empty.
+	OIDCIssuerURL string `mapstructure:"OIDC_ISSUER_URL"`
+	// OIDCClientID is the ID of the client registered with the OpenID Provider.
+	OIDCClientID string `mapstructure:"OIDC_CLIENT_ID"`
+	// OIDCClientSecret is the secret of the client registered with the OpenID Provider.
+	OIDCClientSecret string `mapstructure:"OIDC_CLIENT_SECRET"`
+	// OIDCRedirectURL is the URL of the /auth/oidc/callback endpoint the OpenID Provider redirects users back to.
+	OIDCRedirectURL string `mapstructure:"OIDC_REDIRECT_URL"`
 	// ShortCodeLength is the length of generated room short codes.
 	ShortCodeLength int `mapstructure:"SHORT_CODE_LENGTH"`
 	// MaxMessageQueueSize is the maximum size of the message queue.
 	MaxMessageQueueSize int `mapstructure:"MAX_MESSAGE_QUEUE_SIZE"`
+	// ReplayBufferSize is the number of most recent messages per room kept for replaying to reconnecting users.
+	ReplayBufferSize int `mapstructure:"REPLAY_BUFFER_SIZE"`
+	// DefaultSlowConsumerPolicy is the slow consumer policy applied to chat rooms created without one.
+	// One of DROP_OLDEST, DROP_NEWEST or DISCONNECT.
+	DefaultSlowConsumerPolicy string `mapstructure:"DEFAULT_SLOW_CONSUMER_POLICY"`
+	// OwnerSuccessionPolicy decides what happens to chat rooms left by their owners.
+	// One of NONE, PROMOTE, to make the user present for the longest time the new owner, or DELETE, to delete the room after OwnerSuccessionGracePeriod.
+	OwnerSuccessionPolicy string `mapstructure:"OWNER_SUCCESSION_POLICY"`
+	// OwnerSuccessionGracePeriod is the time after which chat rooms left by their owners are deleted under the DELETE owner succession policy, unless the owner joins them again.
+	OwnerSuccessionGracePeriod time.Duration `mapstructure:"OWNER_SUCCESSION_GRACE_PERIOD"`
+	// RoomIdleTimeout is the time after which chat rooms no user has been present in are deleted. Zero keeps such chat rooms.
+	RoomIdleTimeout time.Duration `mapstructure:"ROOM_IDLE_TIMEOUT"`
+	// RoomReaperInterval is the time between deletions of expired and idle chat rooms.
+	RoomReaperInterval time.Duration `mapstructure:"ROOM_REAPER_INTERVAL"`
+	// Broker is the kind of broker distributing chat room events between application instances.
+	// One of in-process, for a single instance, or postgres, for multiple instances sharing the database.
+	Broker string `mapstructure:"BROKER"`
 	// TokenDuration is a duration for which the JWT token is valid.
 	TokenDuration time.Duration `mapstructure:"TOKEN_DURATION"`
+	// RefreshTokenDuration is a duration for which the refresh token exchanged for a new JWT token is valid.
+	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	// ChatTokenDuration is a duration for which the chat token issued for joining a chat room is valid, unless refreshed.
+	ChatTokenDuration time.Duration `mapstructure:"CHAT_TOKEN_DURATION"`
+	// TokenRevocationStore is the kind of store keeping track of revoked chat tokens.
+	// One of in-memory, for a single instance, or postgres, for multiple instances sharing the database.
+	TokenRevocationStore string `mapstructure:"TOKEN_REVOCATION_STORE"`
 }
 
 // Load loads configuration settings from a specified file or environment variables.
@@ -18,12 +18,39 @@ func TestLoadValid(t *testing.T) {
 	require.Equal(t, "test_db", cfg.DatabaseURL)
 	require.Equal(t, "127.0.0.1", cfg.RESTServerAddress)
 	require.Equal(t, 8080, cfg.RESTServerPort)
+	require.Equal(t, "127.0.0.1", cfg.MetricsServerAddress)
+	require.Equal(t, 9090, cfg.MetricsServerPort)
 	require.Equal(t, "127.0.0.1", cfg.GRPCServerAddress)
 	require.Equal(t, 5000, cfg.GRPCServerPort)
 	require.Equal(t, "123ABC", cfg.Secret)
+	require.Equal(t, "./keys", cfg.SigningKeysDir)
+	require.Equal(t, "2024-01", cfg.SigningKeyID)
+	require.Equal(t, "grpcchatter", cfg.TokenIssuer)
+	require.Equal(t, "grpcchatter-user", cfg.UserTokenAudience)
+	require.Equal(t, "456DEF", cfg.UserTokenSecret)
+	require.Equal(t, "./keys/user", cfg.UserTokenSigningKeysDir)
+	require.Equal(t, "2024-02", cfg.UserTokenSigningKeyID)
+	require.Equal(t, "grpcchatter-chat", cfg.ChatTokenAudience)
+	require.Equal(t, "789GHI", cfg.ChatTokenSecret)
+	require.Equal(t, "./keys/chat", cfg.ChatTokenSigningKeysDir)
+	require.Equal(t, "2024-03", cfg.ChatTokenSigningKeyID)
+	require.Equal(t, "https://sso.example.com", cfg.OIDCIssuerURL)
+	require.Equal(t, "grpcchatter", cfg.OIDCClientID)
+	require.Equal(t, "oidcsecret", cfg.OIDCClientSecret)
+	require.Equal(t, "http://127.0.0.1:8080/auth/oidc/callback", cfg.OIDCRedirectURL)
 	require.Equal(t, 6, cfg.ShortCodeLength)
 	require.Equal(t, 255, cfg.MaxMessageQueueSize)
+	require.Equal(t, 100, cfg.ReplayBufferSize)
+	require.Equal(t, "DISCONNECT", cfg.DefaultSlowConsumerPolicy)
+	require.Equal(t, "postgres", cfg.Broker)
+	require.Equal(t, "DELETE", cfg.OwnerSuccessionPolicy)
+	require.Equal(t, 30*time.Minute, cfg.OwnerSuccessionGracePeriod)
+	require.Equal(t, 72*time.Hour, cfg.RoomIdleTimeout)
+	require.Equal(t, 5*time.Minute, cfg.RoomReaperInterval)
 	require.Equal(t, time.Hour, cfg.TokenDuration)
+	require.Equal(t, 168*time.Hour, cfg.RefreshTokenDuration)
+	require.Equal(t, 15*time.Minute, cfg.ChatTokenDuration)
+	require.Equal(t, "postgres", cfg.TokenRevocationStore)
 }
 
 func TestLoadConfigInvalidPath(t *testing.T) {
@@ -49,6 +76,12 @@ func createTempConfigFile(t *testing.T) string {
 	_, err = file.WriteString("REST_SERVER_PORT=8080\n")
 	require.NoError(t, err)
 
+	_, err = file.WriteString("METRICS_SERVER_ADDRESS=127.0.0.1\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("METRICS_SERVER_PORT=9090\n")
+	require.NoError(t, err)
+
 	_, err = file.WriteString("GRPC_SERVER_ADDRESS=127.0.0.1\n")
 	require.NoError(t, err)
 
@@ -58,6 +91,51 @@ func createTempConfigFile(t *testing.T) string {
 	_, err = file.WriteString("SECRET=123ABC\n")
 	require.NoError(t, err)
 
+	_, err = file.WriteString("SIGNING_KEYS_DIR=./keys\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("SIGNING_KEY_ID=2024-01\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("TOKEN_ISSUER=grpcchatter\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("USER_TOKEN_AUDIENCE=grpcchatter-user\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("USER_TOKEN_SECRET=456DEF\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("USER_TOKEN_SIGNING_KEYS_DIR=./keys/user\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("USER_TOKEN_SIGNING_KEY_ID=2024-02\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("CHAT_TOKEN_AUDIENCE=grpcchatter-chat\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("CHAT_TOKEN_SECRET=789GHI\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("CHAT_TOKEN_SIGNING_KEYS_DIR=./keys/chat\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("CHAT_TOKEN_SIGNING_KEY_ID=2024-03\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OIDC_ISSUER_URL=https://sso.example.com\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OIDC_CLIENT_ID=grpcchatter\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OIDC_CLIENT_SECRET=oidcsecret\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OIDC_REDIRECT_URL=http://127.0.0.1:8080/auth/oidc/callback\n")
+	require.NoError(t, err)
+
 	_, err = file.WriteString("SHORT_CODE_LENGTH=6\n")
 	require.NoError(t, err)
 
@@ -67,5 +145,35 @@ func createTempConfigFile(t *testing.T) string {
 	_, err = file.WriteString("TOKEN_DURATION=1h\n")
 	require.NoError(t, err)
 
+	_, err = file.WriteString("REFRESH_TOKEN_DURATION=168h\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("CHAT_TOKEN_DURATION=15m\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("TOKEN_REVOCATION_STORE=postgres\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("REPLAY_BUFFER_SIZE=100\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("DEFAULT_SLOW_CONSUMER_POLICY=DISCONNECT\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("BROKER=postgres\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OWNER_SUCCESSION_POLICY=DELETE\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("OWNER_SUCCESSION_GRACE_PERIOD=30m\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("ROOM_IDLE_TIMEOUT=72h\n")
+	require.NoError(t, err)
+
+	_, err = file.WriteString("ROOM_REAPER_INTERVAL=5m\n")
+	require.NoError(t, err)
+
 	return configFile
 }
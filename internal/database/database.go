@@ -26,3 +26,10 @@ type Database interface {
 	// It should be called when you're done using the database to release resources.
 	Close() error
 }
+
+// Listener is an interface that defines the methods required for receiving asynchronous notifications from a database.
+type Listener interface {
+	// Listen starts listening for notifications on the given channel until the context is done.
+	// Payloads of received notifications are sent on the returned channel, which is closed once listening stops.
+	Listen(ctx context.Context, channel string) (<-chan string, error)
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/lib/pq"
+)
+
+const (
+	listenerMinReconnectInterval = 1 * time.Second
+	listenerMaxReconnectInterval = 30 * time.Second
 )
 
-// PostgresDatabase implements the Database interface for PostgreSQL.
+// PostgresDatabase implements the Database and Listener interfaces for PostgreSQL.
 type PostgresDatabase struct {
-	db *sql.DB
+	db               *sql.DB
+	connectionString string
 }
 
 // NewPostgresDatabase creates a new PostgresDatabase instance with the connection string and context.
@@ -24,7 +34,8 @@ func NewPostgresDatabase(ctx context.Context, connectionString string) (*Postgre
 	}
 
 	return &PostgresDatabase{
-		db: db,
+		db:               db,
+		connectionString: connectionString,
 	}, nil
 }
 
@@ -46,3 +57,45 @@ func (pdb *PostgresDatabase) Close() error {
 	}
 	return nil
 }
+
+// Listen listens for notifications sent with NOTIFY or pg_notify on a dedicated connection.
+// The connection is re-established if lost; notifications sent in the meantime are missed.
+func (pdb *PostgresDatabase) Listen(ctx context.Context, channel string) (<-chan string, error) {
+	listener := pq.NewListener(pdb.connectionString, listenerMinReconnectInterval, listenerMaxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error(fmt.Sprintf("Database listener on channel [%s] failed: %s", channel, err))
+		}
+	})
+
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to listen on channel %s: %w", channel, err)
+	}
+
+	payloads := make(chan string)
+
+	go func() {
+		defer close(payloads)
+		defer listener.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification := <-listener.Notify:
+				// A nil notification is sent after the connection has been re-established.
+				if notification == nil {
+					continue
+				}
+
+				select {
+				case payloads <- notification.Extra:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return payloads, nil
+}
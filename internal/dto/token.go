@@ -1,6 +1,17 @@
 package dto
 
+import "time"
+
 // TokenDTO represents a data transfer object (DTO) for a token.
 type TokenDTO struct {
 	Token string `json:"token"`
+	// ExpiresAt is the time the token expires at.
+	ExpiresAt time.Time `json:"expires_at"`
+	// RefreshToken is exchanged for a new token and refresh token once the token expires.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshTokenDTO represents a data transfer object (DTO) for refresh and logout requests.
+type RefreshTokenDTO struct {
+	RefreshToken string `json:"refresh_token"`
 }
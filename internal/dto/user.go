@@ -21,3 +21,11 @@ type UserLoginDTO struct {
 	Username string `json:"user_name"`
 	Password string `json:"password"`
 }
+
+// ExternalUserDTO represents a data transfer object (DTO) for a user authenticated by an external identity provider.
+type ExternalUserDTO struct {
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+	// Username is the user name preferred for a new user. Another one is chosen if it is invalid or already taken.
+	Username string `json:"user_name"`
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Ban represents a model for a ban of a user from a chat room.
+type Ban struct {
+	ShortCode string    `json:"short_code"`
+	UserName  string    `json:"user_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
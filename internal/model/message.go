@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// Message represents a model for a chat message.
+type Message struct {
+	ID        int        `json:"id"`
+	MessageID string     `json:"message_id"`
+	Sequence  uint64     `json:"sequence"`
+	CreatedAt time.Time  `json:"created_at"`
+	ShortCode string     `json:"short_code"`
+	Sender    string     `json:"sender"`
+	Body      string     `json:"body"`
+	Recipient string     `json:"recipient,omitempty"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+	ReplyTo   string     `json:"reply_to,omitempty"`
+
+	// Reactions holds the number of users who reacted to the message with each emoji. It is not stored with the message.
+	Reactions map[string]int `json:"reactions,omitempty"`
+}
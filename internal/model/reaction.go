@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Reaction represents a model for a reaction of a user to a chat message.
+type Reaction struct {
+	MessageID string    `json:"message_id"`
+	ShortCode string    `json:"short_code"`
+	UserName  string    `json:"user_name"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"created_at"`
+}
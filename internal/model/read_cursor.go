@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// ReadCursor represents a model for the position up to which a user has read the messages of a chat room.
+type ReadCursor struct {
+	ShortCode        string    `json:"short_code"`
+	UserName         string    `json:"user_name"`
+	LastReadSequence uint64    `json:"last_read_sequence"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// RefreshToken represents a model for a refresh token, which is exchanged for a new access token and refresh token.
+// Refresh tokens issued one for another, starting from a login, form a family.
+type RefreshToken struct {
+	ID        int       `json:"id"`
+	FamilyID  string    `json:"family_id"`
+	UserID    int       `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Used      bool      `json:"used"`
+	Revoked   bool      `json:"revoked"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// Room represents a model for a chat room.
+type Room struct {
+	ShortCode   string    `json:"short_code"`
+	CreatedAt   time.Time `json:"created_at"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Password    string    `json:"password"`
+	Owner       string    `json:"owner"`
+
+	SlowConsumerPolicy string `json:"slow_consumer_policy"`
+	Public             bool   `json:"public"`
+	LastSequence       uint64 `json:"last_sequence"`
+
+	// ExpiresAt is the time the chat room expires at, if it has a time to live.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// RoomMember represents a model for the membership of a user in a chat room, together with their role in it.
+type RoomMember struct {
+	ShortCode string    `json:"short_code"`
+	UserName  string    `json:"user_name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -2,6 +2,13 @@ package model
 
 import "time"
 
+const (
+	// RoleUser is the role of regular users, given to users when they register.
+	RoleUser = "USER"
+	// RoleAdmin is the role of users permitted to administer all chat rooms.
+	RoleAdmin = "ADMIN"
+)
+
 // User represents a model for a user.
 type User struct {
 	ID        int       `json:"id"`
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// BanRepository is an interface that defines the methods required for chat room ban data management.
+type BanRepository interface {
+	// AddBan adds a new ban to the database.
+	// It reports whether the ban has been added, which is not the case if the user is already banned from the chat room.
+	AddBan(ctx context.Context, ban *model.Ban) (added bool, err error)
+
+	// DeleteBan deletes the ban of the user with the given user name from the chat room with the given short code.
+	// It reports whether the ban has been deleted, which is not the case if the user is not banned from the chat room.
+	DeleteBan(ctx context.Context, shortCode, userName string) (deleted bool, err error)
+
+	// GetBans retrieves all bans from the chat room with the given short code.
+	GetBans(ctx context.Context, shortCode string) (bans []*model.Ban, err error)
+}
+
+// BanRepositoryImpl implements the BanRepository interface.
+type BanRepositoryImpl struct {
+	db database.Database
+}
+
+// NewBanRepository creates a new BanRepositoryImpl instance with the provided database.
+func NewBanRepository(db database.Database) *BanRepositoryImpl {
+	return &BanRepositoryImpl{
+		db: db,
+	}
+}
+
+func (br *BanRepositoryImpl) AddBan(ctx context.Context, ban *model.Ban) (bool, error) {
+	query := `
+		INSERT INTO bans (short_code, user_name, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := br.db.ExecContext(ctx, query, ban.ShortCode, ban.UserName, ban.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to add ban: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to add ban: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (br *BanRepositoryImpl) DeleteBan(ctx context.Context, shortCode, userName string) (bool, error) {
+	query := "DELETE FROM bans WHERE short_code = $1 AND user_name = $2"
+
+	result, err := br.db.ExecContext(ctx, query, shortCode, userName)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete ban: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete ban: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (br *BanRepositoryImpl) GetBans(ctx context.Context, shortCode string) ([]*model.Ban, error) {
+	query := "SELECT short_code, user_name, created_at FROM bans WHERE short_code = $1"
+
+	rows, err := br.db.QueryContext(ctx, query, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bans: %w", err)
+	}
+	defer rows.Close()
+
+	bans := []*model.Ban{}
+	for rows.Next() {
+		var ban model.Ban
+		if err := rows.Scan(&ban.ShortCode, &ban.UserName, &ban.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan ban row: %w", err)
+		}
+		bans = append(bans, &ban)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return bans, nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MessageRepository is an interface that defines the methods required for chat message data management.
+type MessageRepository interface {
+	// AddMessage adds a new chat message to the database.
+	// It assigns the message the next sequence number in its chat room, so that sequence numbers are unique even if messages are added by multiple instances.
+	// If the chat room does not exist, for example because it has just been deleted, the message is not added and nil is returned.
+	AddMessage(ctx context.Context, message *model.Message) (addedMessage *model.Message, err error)
+
+	// GetMessages retrieves up to limit messages sent in the chat room with the given short code, newest first.
+	// Only messages with an ID lower than before are returned. If before is 0, the newest messages are returned.
+	// Private messages are returned only if the user with the given user name is their sender or recipient. If userName is empty, all messages are returned.
+	GetMessages(ctx context.Context, shortCode, userName string, before, limit int) (messages []*model.Message, err error)
+
+	// GetMessage retrieves a chat message sent in the chat room with the given short code by its message ID.
+	// If the message does not exist, nil is returned.
+	GetMessage(ctx context.Context, shortCode, messageID string) (message *model.Message, err error)
+
+	// UpdateMessage updates the body and the edit time of a chat message in the database by its message ID.
+	UpdateMessage(ctx context.Context, message *model.Message) (err error)
+
+	// DeleteMessage deletes a chat message from the database by its message ID.
+	DeleteMessage(ctx context.Context, messageID string) (err error)
+
+	// CountUnreadMessages counts the messages sent in the chat room with the given short code with a sequence number greater than afterSequence,
+	// which are visible to the user with the given user name and have not been sent by them.
+	CountUnreadMessages(ctx context.Context, shortCode, userName string, afterSequence uint64) (count int, err error)
+}
+
+// MessageRepositoryImpl implements the MessageRepository interface.
+type MessageRepositoryImpl struct {
+	db database.Database
+}
+
+// NewMessageRepository creates a new MessageRepositoryImpl instance with the provided database.
+func NewMessageRepository(db database.Database) *MessageRepositoryImpl {
+	return &MessageRepositoryImpl{
+		db: db,
+	}
+}
+
+func (mr *MessageRepositoryImpl) AddMessage(ctx context.Context, message *model.Message) (*model.Message, error) {
+	// Updating the room's row locks it until the end of the transaction, so concurrent inserts get consecutive sequence numbers.
+	query := `
+		WITH room AS (
+			UPDATE rooms SET last_sequence = last_sequence + 1 WHERE short_code = $1 RETURNING last_sequence
+		)
+		INSERT INTO messages (message_id, sequence, created_at, short_code, sender, body, recipient, reply_to)
+		SELECT $2, last_sequence, $3, $1, $4, $5, $6, $7 FROM room
+		RETURNING id, sequence
+	`
+
+	row, err := mr.db.QueryRowContext(ctx, query, message.ShortCode, message.MessageID, message.CreatedAt, message.Sender, message.Body, message.Recipient, message.ReplyTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add message: %w", err)
+	}
+
+	if err = row.Scan(&message.ID, &message.Sequence); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to add message: %w", err)
+	}
+
+	return message, nil
+}
+
+func (mr *MessageRepositoryImpl) GetMessages(ctx context.Context, shortCode, userName string, before, limit int) ([]*model.Message, error) {
+	query := `
+		SELECT id, message_id, sequence, created_at, short_code, sender, body, recipient, edited_at, reply_to
+		FROM messages
+		WHERE short_code = $1 AND ($2::bigint = 0 OR id < $2::bigint)
+			AND ($4::varchar = '' OR recipient = '' OR recipient = $4::varchar OR sender = $4::varchar)
+		ORDER BY id DESC
+		LIMIT $3
+	`
+
+	rows, err := mr.db.QueryContext(ctx, query, shortCode, before, limit, userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := []*model.Message{}
+	for rows.Next() {
+		var message model.Message
+		if err := rows.Scan(&message.ID, &message.MessageID, &message.Sequence, &message.CreatedAt, &message.ShortCode, &message.Sender, &message.Body, &message.Recipient, &message.EditedAt, &message.ReplyTo); err != nil {
+			return nil, fmt.Errorf("failed to scan message row: %w", err)
+		}
+		messages = append(messages, &message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (mr *MessageRepositoryImpl) GetMessage(ctx context.Context, shortCode, messageID string) (*model.Message, error) {
+	query := `
+		SELECT id, message_id, sequence, created_at, short_code, sender, body, recipient, edited_at, reply_to
+		FROM messages
+		WHERE short_code = $1 AND message_id = $2
+	`
+
+	row, err := mr.db.QueryRowContext(ctx, query, shortCode, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	var message model.Message
+	if err := row.Scan(&message.ID, &message.MessageID, &message.Sequence, &message.CreatedAt, &message.ShortCode, &message.Sender, &message.Body, &message.Recipient, &message.EditedAt, &message.ReplyTo); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return &message, nil
+}
+
+func (mr *MessageRepositoryImpl) UpdateMessage(ctx context.Context, message *model.Message) error {
+	query := "UPDATE messages SET body = $2, edited_at = $3 WHERE message_id = $1"
+
+	if _, err := mr.db.ExecContext(ctx, query, message.MessageID, message.Body, message.EditedAt); err != nil {
+		return fmt.Errorf("failed to update message: %w", err)
+	}
+
+	return nil
+}
+
+func (mr *MessageRepositoryImpl) DeleteMessage(ctx context.Context, messageID string) error {
+	query := "DELETE FROM messages WHERE message_id = $1"
+
+	if _, err := mr.db.ExecContext(ctx, query, messageID); err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	return nil
+}
+
+func (mr *MessageRepositoryImpl) CountUnreadMessages(ctx context.Context, shortCode, userName string, afterSequence uint64) (int, error) {
+	query := `
+		SELECT count(*)
+		FROM messages
+		WHERE short_code = $1 AND sequence > $2 AND sender <> $3 AND (recipient = '' OR recipient = $3)
+	`
+
+	row, err := mr.db.QueryRowContext(ctx, query, shortCode, afterSequence, userName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+
+	return count, nil
+}
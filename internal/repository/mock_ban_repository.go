@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockBanRepository is a mock implementation of BanRepository for testing purposes.
+type MockBanRepository struct {
+	mu   sync.Mutex
+	Bans []*model.Ban // Slice to store bans
+}
+
+// NewMockBanRepository creates a new instance of MockBanRepository.
+func NewMockBanRepository() *MockBanRepository {
+	return &MockBanRepository{}
+}
+
+// AddBan is a mock implementation of AddBan method.
+func (m *MockBanRepository) AddBan(ctx context.Context, ban *model.Ban) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.Bans {
+		if stored.ShortCode == ban.ShortCode && stored.UserName == ban.UserName {
+			return false, nil
+		}
+	}
+
+	m.Bans = append(m.Bans, ban)
+	return true, nil
+}
+
+// DeleteBan is a mock implementation of DeleteBan method.
+func (m *MockBanRepository) DeleteBan(ctx context.Context, shortCode, userName string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, stored := range m.Bans {
+		if stored.ShortCode == shortCode && stored.UserName == userName {
+			m.Bans = append(m.Bans[:i], m.Bans[i+1:]...)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetBans is a mock implementation of GetBans method.
+func (m *MockBanRepository) GetBans(ctx context.Context, shortCode string) ([]*model.Ban, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bans := []*model.Ban{}
+	for _, stored := range m.Bans {
+		if stored.ShortCode == shortCode {
+			bans = append(bans, stored)
+		}
+	}
+
+	return bans, nil
+}
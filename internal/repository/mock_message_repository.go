@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockMessageRepository is a mock implementation of MessageRepository for testing purposes.
+type MockMessageRepository struct {
+	mu             sync.Mutex
+	Messages       map[int]*model.Message // Map to store messages by ID
+	LastInsertedID int                    // To simulate auto-increment behavior
+	lastSequences  map[string]uint64      // To simulate per-room sequence numbers
+}
+
+// NewMockMessageRepository creates a new instance of MockMessageRepository.
+func NewMockMessageRepository() *MockMessageRepository {
+	return &MockMessageRepository{
+		Messages:      make(map[int]*model.Message),
+		lastSequences: make(map[string]uint64),
+	}
+}
+
+// AddMessage is a mock implementation of AddMessage method.
+func (m *MockMessageRepository) AddMessage(ctx context.Context, message *model.Message) (*model.Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.LastInsertedID++
+	message.ID = m.LastInsertedID
+
+	m.lastSequences[message.ShortCode]++
+	message.Sequence = m.lastSequences[message.ShortCode]
+
+	m.Messages[message.ID] = message
+	return message, nil
+}
+
+// GetMessages is a mock implementation of GetMessages method.
+func (m *MockMessageRepository) GetMessages(ctx context.Context, shortCode, userName string, before, limit int) ([]*model.Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	messages := []*model.Message{}
+	for _, message := range m.Messages {
+		visible := userName == "" || message.Recipient == "" || message.Recipient == userName || message.Sender == userName
+		if message.ShortCode == shortCode && (before == 0 || message.ID < before) && visible {
+			messages = append(messages, message)
+		}
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID > messages[j].ID
+	})
+
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+
+	return messages, nil
+}
+
+// GetMessage is a mock implementation of GetMessage method.
+func (m *MockMessageRepository) GetMessage(ctx context.Context, shortCode, messageID string) (*model.Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, message := range m.Messages {
+		if message.ShortCode == shortCode && message.MessageID == messageID {
+			stored := *message
+			return &stored, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateMessage is a mock implementation of UpdateMessage method.
+func (m *MockMessageRepository) UpdateMessage(ctx context.Context, message *model.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, stored := range m.Messages {
+		if stored.MessageID == message.MessageID {
+			updated := *stored
+			updated.Body, updated.EditedAt = message.Body, message.EditedAt
+			m.Messages[id] = &updated
+		}
+	}
+
+	return nil
+}
+
+// DeleteMessage is a mock implementation of DeleteMessage method.
+func (m *MockMessageRepository) DeleteMessage(ctx context.Context, messageID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, stored := range m.Messages {
+		if stored.MessageID == messageID {
+			delete(m.Messages, id)
+		}
+	}
+
+	return nil
+}
+
+// CountUnreadMessages is a mock implementation of CountUnreadMessages method.
+func (m *MockMessageRepository) CountUnreadMessages(ctx context.Context, shortCode, userName string, afterSequence uint64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	count := 0
+	for _, message := range m.Messages {
+		if message.ShortCode == shortCode && message.Sequence > afterSequence && message.Sender != userName && (message.Recipient == "" || message.Recipient == userName) {
+			count++
+		}
+	}
+
+	return count, nil
+}
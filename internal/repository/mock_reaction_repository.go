@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockReactionRepository is a mock implementation of ReactionRepository for testing purposes.
+type MockReactionRepository struct {
+	mu        sync.Mutex
+	Reactions []*model.Reaction // Slice to store reactions
+}
+
+// NewMockReactionRepository creates a new instance of MockReactionRepository.
+func NewMockReactionRepository() *MockReactionRepository {
+	return &MockReactionRepository{}
+}
+
+// AddReaction is a mock implementation of AddReaction method.
+func (m *MockReactionRepository) AddReaction(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.Reactions {
+		if stored.MessageID == reaction.MessageID && stored.UserName == reaction.UserName && stored.Emoji == reaction.Emoji {
+			return false, nil
+		}
+	}
+
+	m.Reactions = append(m.Reactions, reaction)
+	return true, nil
+}
+
+// DeleteReaction is a mock implementation of DeleteReaction method.
+func (m *MockReactionRepository) DeleteReaction(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, stored := range m.Reactions {
+		if stored.MessageID == reaction.MessageID && stored.UserName == reaction.UserName && stored.Emoji == reaction.Emoji {
+			m.Reactions = append(m.Reactions[:i], m.Reactions[i+1:]...)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetReactions is a mock implementation of GetReactions method.
+func (m *MockReactionRepository) GetReactions(ctx context.Context, shortCode string) ([]*model.Reaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reactions := []*model.Reaction{}
+	for _, stored := range m.Reactions {
+		if stored.ShortCode == shortCode {
+			reactions = append(reactions, stored)
+		}
+	}
+
+	return reactions, nil
+}
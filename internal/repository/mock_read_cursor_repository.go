@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockReadCursorRepository is a mock implementation of ReadCursorRepository for testing purposes.
+type MockReadCursorRepository struct {
+	mu          sync.Mutex
+	ReadCursors []*model.ReadCursor // Slice to store read cursors
+}
+
+// NewMockReadCursorRepository creates a new instance of MockReadCursorRepository.
+func NewMockReadCursorRepository() *MockReadCursorRepository {
+	return &MockReadCursorRepository{}
+}
+
+// AddReadCursor is a mock implementation of AddReadCursor method.
+func (m *MockReadCursorRepository) AddReadCursor(ctx context.Context, cursor *model.ReadCursor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.find(cursor.ShortCode, cursor.UserName) == nil {
+		stored := *cursor
+		m.ReadCursors = append(m.ReadCursors, &stored)
+	}
+
+	return nil
+}
+
+// UpdateReadCursor is a mock implementation of UpdateReadCursor method.
+func (m *MockReadCursorRepository) UpdateReadCursor(ctx context.Context, cursor *model.ReadCursor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.find(cursor.ShortCode, cursor.UserName)
+	if stored == nil {
+		added := *cursor
+		m.ReadCursors = append(m.ReadCursors, &added)
+		return true, nil
+	}
+
+	if stored.LastReadSequence >= cursor.LastReadSequence {
+		return false, nil
+	}
+
+	stored.LastReadSequence, stored.UpdatedAt = cursor.LastReadSequence, cursor.UpdatedAt
+	return true, nil
+}
+
+// GetReadCursors is a mock implementation of GetReadCursors method.
+func (m *MockReadCursorRepository) GetReadCursors(ctx context.Context, userName string) ([]*model.ReadCursor, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cursors := []*model.ReadCursor{}
+	for _, stored := range m.ReadCursors {
+		if stored.UserName == userName {
+			cursor := *stored
+			cursors = append(cursors, &cursor)
+		}
+	}
+
+	return cursors, nil
+}
+
+// GetRoomReadCursors is a mock implementation of GetRoomReadCursors method.
+func (m *MockReadCursorRepository) GetRoomReadCursors(ctx context.Context, shortCode string) ([]*model.ReadCursor, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cursors := []*model.ReadCursor{}
+	for _, stored := range m.ReadCursors {
+		if stored.ShortCode == shortCode {
+			cursor := *stored
+			cursors = append(cursors, &cursor)
+		}
+	}
+
+	return cursors, nil
+}
+
+func (m *MockReadCursorRepository) find(shortCode, userName string) *model.ReadCursor {
+	for _, stored := range m.ReadCursors {
+		if stored.ShortCode == shortCode && stored.UserName == userName {
+			return stored
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockRefreshTokenRepository is a mock implementation of RefreshTokenRepository for testing purposes.
+type MockRefreshTokenRepository struct {
+	mu             sync.Mutex
+	RefreshTokens  map[int]*model.RefreshToken // Map to store refresh tokens by ID
+	LastInsertedID int                         // To simulate auto-increment behavior
+}
+
+// NewMockRefreshTokenRepository creates a new instance of MockRefreshTokenRepository.
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		RefreshTokens: make(map[int]*model.RefreshToken),
+	}
+}
+
+// AddRefreshToken is a mock implementation of AddRefreshToken method.
+func (m *MockRefreshTokenRepository) AddRefreshToken(ctx context.Context, refreshToken *model.RefreshToken) (*model.RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.LastInsertedID++
+	refreshToken.ID = m.LastInsertedID
+	stored := *refreshToken
+	m.RefreshTokens[refreshToken.ID] = &stored
+	return refreshToken, nil
+}
+
+// GetRefreshTokenByHash is a mock implementation of GetRefreshTokenByHash method.
+func (m *MockRefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.RefreshTokens {
+		if stored.TokenHash == tokenHash {
+			refreshToken := *stored
+			return &refreshToken, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UseRefreshToken is a mock implementation of UseRefreshToken method.
+func (m *MockRefreshTokenRepository) UseRefreshToken(ctx context.Context, refreshTokenID int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.RefreshTokens[refreshTokenID]
+	if !ok || stored.Used {
+		return false, nil
+	}
+
+	stored.Used = true
+	return true, nil
+}
+
+// RevokeRefreshTokenFamily is a mock implementation of RevokeRefreshTokenFamily method.
+func (m *MockRefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.RefreshTokens {
+		if stored.FamilyID == familyID {
+			stored.Revoked = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockRoomMemberRepository is a mock implementation of RoomMemberRepository for testing purposes.
+type MockRoomMemberRepository struct {
+	mu          sync.Mutex
+	RoomMembers []*model.RoomMember // Slice to store room members
+}
+
+// NewMockRoomMemberRepository creates a new instance of MockRoomMemberRepository.
+func NewMockRoomMemberRepository() *MockRoomMemberRepository {
+	return &MockRoomMemberRepository{}
+}
+
+// AddRoomMember is a mock implementation of AddRoomMember method.
+func (m *MockRoomMemberRepository) AddRoomMember(ctx context.Context, member *model.RoomMember) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.find(member.ShortCode, member.UserName) == nil {
+		stored := *member
+		m.RoomMembers = append(m.RoomMembers, &stored)
+	}
+
+	return nil
+}
+
+// GetRoomMembers is a mock implementation of GetRoomMembers method.
+func (m *MockRoomMemberRepository) GetRoomMembers(ctx context.Context, shortCode string) ([]*model.RoomMember, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	members := []*model.RoomMember{}
+	for _, stored := range m.RoomMembers {
+		if stored.ShortCode == shortCode {
+			member := *stored
+			members = append(members, &member)
+		}
+	}
+
+	return members, nil
+}
+
+// UpdateRole is a mock implementation of UpdateRole method.
+func (m *MockRoomMemberRepository) UpdateRole(ctx context.Context, shortCode, userName, role string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.find(shortCode, userName)
+	if stored == nil {
+		return false, nil
+	}
+
+	stored.Role = role
+	return true, nil
+}
+
+func (m *MockRoomMemberRepository) find(shortCode, userName string) *model.RoomMember {
+	for _, stored := range m.RoomMembers {
+		if stored.ShortCode == shortCode && stored.UserName == userName {
+			return stored
+		}
+	}
+
+	return nil
+}
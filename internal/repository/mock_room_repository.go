@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// MockRoomRepository is a mock implementation of RoomRepository for testing purposes.
+type MockRoomRepository struct {
+	mu    sync.Mutex
+	Rooms map[string]*model.Room // Map to store rooms by short code
+}
+
+// NewMockRoomRepository creates a new instance of MockRoomRepository.
+func NewMockRoomRepository() *MockRoomRepository {
+	return &MockRoomRepository{
+		Rooms: make(map[string]*model.Room),
+	}
+}
+
+// AddRoom is a mock implementation of AddRoom method.
+func (m *MockRoomRepository) AddRoom(ctx context.Context, room *model.Room) (*model.Room, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Rooms[room.ShortCode] = room
+	return room, nil
+}
+
+// DeleteRoom is a mock implementation of DeleteRoom method.
+func (m *MockRoomRepository) DeleteRoom(ctx context.Context, shortCode string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.Rooms, shortCode)
+	return nil
+}
+
+// UpdateRoom is a mock implementation of UpdateRoom method.
+func (m *MockRoomRepository) UpdateRoom(ctx context.Context, room *model.Room) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if stored, ok := m.Rooms[room.ShortCode]; ok {
+		updated := *stored
+		updated.Name, updated.Description, updated.Password = room.Name, room.Description, room.Password
+		m.Rooms[room.ShortCode] = &updated
+	}
+
+	return nil
+}
+
+// UpdateOwner is a mock implementation of UpdateOwner method.
+func (m *MockRoomRepository) UpdateOwner(ctx context.Context, shortCode, owner, newOwner string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.Rooms[shortCode]
+	if !ok || stored.Owner != owner {
+		return false, nil
+	}
+
+	updated := *stored
+	updated.Owner = newOwner
+	m.Rooms[shortCode] = &updated
+
+	return true, nil
+}
+
+// GetRoomByShortCode is a mock implementation of GetRoomByShortCode method.
+func (m *MockRoomRepository) GetRoomByShortCode(ctx context.Context, shortCode string) (*model.Room, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room, ok := m.Rooms[shortCode]
+	if !ok {
+		return nil, nil
+	}
+	return room, nil
+}
+
+// GetAllRooms is a mock implementation of GetAllRooms method.
+func (m *MockRoomRepository) GetAllRooms(ctx context.Context) ([]*model.Room, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rooms := make([]*model.Room, 0, len(m.Rooms))
+	for _, room := range m.Rooms {
+		rooms = append(rooms, room)
+	}
+
+	return rooms, nil
+}
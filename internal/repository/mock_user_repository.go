@@ -9,13 +9,15 @@ import (
 // MockUserRepository is a mock implementation of UserRepository for testing purposes.
 type MockUserRepository struct {
 	Users          map[int]*model.User // Map to store users by ID
+	Identities     map[string]int      // Map to store user IDs by external identity
 	LastInsertedID int                 // To simulate auto-increment behavior
 }
 
 // NewMockUserRepository creates a new instance of MockUserRepository.
 func NewMockUserRepository() *MockUserRepository {
 	return &MockUserRepository{
-		Users: make(map[int]*model.User),
+		Users:      make(map[int]*model.User),
+		Identities: make(map[string]int),
 	}
 }
 
@@ -61,3 +63,24 @@ func (m *MockUserRepository) GetAllUsers(ctx context.Context) ([]*model.User, er
 
 	return users, nil
 }
+
+// AddExternalUser is a mock implementation of AddExternalUser method.
+func (m *MockUserRepository) AddExternalUser(ctx context.Context, user *model.User, issuer, subject string) (*model.User, error) {
+	user, err := m.AddUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Identities[issuer+" "+subject] = user.ID
+	return user, nil
+}
+
+// GetUserByExternalIdentity is a mock implementation of GetUserByExternalIdentity method.
+func (m *MockUserRepository) GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*model.User, error) {
+	userID, ok := m.Identities[issuer+" "+subject]
+	if !ok {
+		return nil, nil
+	}
+
+	return m.GetUserByID(ctx, userID)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// ReactionRepository is an interface that defines the methods required for message reaction data management.
+type ReactionRepository interface {
+	// AddReaction adds a new reaction to the database.
+	// It reports whether the reaction has been added, which is not the case if the user has already reacted to the message with the same emoji.
+	AddReaction(ctx context.Context, reaction *model.Reaction) (added bool, err error)
+
+	// DeleteReaction deletes a reaction from the database.
+	// It reports whether the reaction has been deleted, which is not the case if the user has not reacted to the message with the emoji.
+	DeleteReaction(ctx context.Context, reaction *model.Reaction) (deleted bool, err error)
+
+	// GetReactions retrieves all reactions to messages sent in the chat room with the given short code.
+	GetReactions(ctx context.Context, shortCode string) (reactions []*model.Reaction, err error)
+}
+
+// ReactionRepositoryImpl implements the ReactionRepository interface.
+type ReactionRepositoryImpl struct {
+	db database.Database
+}
+
+// NewReactionRepository creates a new ReactionRepositoryImpl instance with the provided database.
+func NewReactionRepository(db database.Database) *ReactionRepositoryImpl {
+	return &ReactionRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rr *ReactionRepositoryImpl) AddReaction(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	query := `
+		INSERT INTO reactions (message_id, short_code, user_name, emoji, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := rr.db.ExecContext(ctx, query, reaction.MessageID, reaction.ShortCode, reaction.UserName, reaction.Emoji, reaction.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (rr *ReactionRepositoryImpl) DeleteReaction(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	query := "DELETE FROM reactions WHERE message_id = $1 AND user_name = $2 AND emoji = $3"
+
+	result, err := rr.db.ExecContext(ctx, query, reaction.MessageID, reaction.UserName, reaction.Emoji)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reaction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reaction: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (rr *ReactionRepositoryImpl) GetReactions(ctx context.Context, shortCode string) ([]*model.Reaction, error) {
+	query := "SELECT message_id, short_code, user_name, emoji, created_at FROM reactions WHERE short_code = $1"
+
+	rows, err := rr.db.QueryContext(ctx, query, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+	defer rows.Close()
+
+	reactions := []*model.Reaction{}
+	for rows.Next() {
+		var reaction model.Reaction
+		if err := rows.Scan(&reaction.MessageID, &reaction.ShortCode, &reaction.UserName, &reaction.Emoji, &reaction.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reaction row: %w", err)
+		}
+		reactions = append(reactions, &reaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return reactions, nil
+}
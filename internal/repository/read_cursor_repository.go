@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// ReadCursorRepository is an interface that defines the methods required for read cursor data management.
+type ReadCursorRepository interface {
+	// AddReadCursor adds a new read cursor to the database, unless the user already has one in the chat room.
+	AddReadCursor(ctx context.Context, cursor *model.ReadCursor) (err error)
+
+	// UpdateReadCursor moves the user's read cursor in the chat room forward, adding it if the user does not have one yet.
+	// It reports whether the cursor has been moved, which is not the case if it is already at or past the given sequence number.
+	UpdateReadCursor(ctx context.Context, cursor *model.ReadCursor) (updated bool, err error)
+
+	// GetReadCursors retrieves the read cursors of the user with the given user name in all chat rooms.
+	GetReadCursors(ctx context.Context, userName string) (cursors []*model.ReadCursor, err error)
+
+	// GetRoomReadCursors retrieves the read cursors of all members of the chat room with the given short code.
+	GetRoomReadCursors(ctx context.Context, shortCode string) (cursors []*model.ReadCursor, err error)
+}
+
+// ReadCursorRepositoryImpl implements the ReadCursorRepository interface.
+type ReadCursorRepositoryImpl struct {
+	db database.Database
+}
+
+// NewReadCursorRepository creates a new ReadCursorRepositoryImpl instance with the provided database.
+func NewReadCursorRepository(db database.Database) *ReadCursorRepositoryImpl {
+	return &ReadCursorRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rcr *ReadCursorRepositoryImpl) AddReadCursor(ctx context.Context, cursor *model.ReadCursor) error {
+	query := `
+		INSERT INTO read_cursors (short_code, user_name, last_read_sequence, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`
+
+	if _, err := rcr.db.ExecContext(ctx, query, cursor.ShortCode, cursor.UserName, cursor.LastReadSequence, cursor.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to add read cursor: %w", err)
+	}
+
+	return nil
+}
+
+func (rcr *ReadCursorRepositoryImpl) UpdateReadCursor(ctx context.Context, cursor *model.ReadCursor) (bool, error) {
+	query := `
+		INSERT INTO read_cursors (short_code, user_name, last_read_sequence, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (short_code, user_name) DO UPDATE
+		SET last_read_sequence = EXCLUDED.last_read_sequence, updated_at = EXCLUDED.updated_at
+		WHERE read_cursors.last_read_sequence < EXCLUDED.last_read_sequence
+	`
+
+	result, err := rcr.db.ExecContext(ctx, query, cursor.ShortCode, cursor.UserName, cursor.LastReadSequence, cursor.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to update read cursor: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update read cursor: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (rcr *ReadCursorRepositoryImpl) GetReadCursors(ctx context.Context, userName string) ([]*model.ReadCursor, error) {
+	query := "SELECT short_code, user_name, last_read_sequence, updated_at FROM read_cursors WHERE user_name = $1"
+
+	return rcr.getReadCursors(ctx, query, userName)
+}
+
+func (rcr *ReadCursorRepositoryImpl) GetRoomReadCursors(ctx context.Context, shortCode string) ([]*model.ReadCursor, error) {
+	query := "SELECT short_code, user_name, last_read_sequence, updated_at FROM read_cursors WHERE short_code = $1"
+
+	return rcr.getReadCursors(ctx, query, shortCode)
+}
+
+func (rcr *ReadCursorRepositoryImpl) getReadCursors(ctx context.Context, query string, args ...any) ([]*model.ReadCursor, error) {
+	rows, err := rcr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read cursors: %w", err)
+	}
+	defer rows.Close()
+
+	cursors := []*model.ReadCursor{}
+	for rows.Next() {
+		var cursor model.ReadCursor
+		if err := rows.Scan(&cursor.ShortCode, &cursor.UserName, &cursor.LastReadSequence, &cursor.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan read cursor row: %w", err)
+		}
+		cursors = append(cursors, &cursor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return cursors, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// RefreshTokenRepository is an interface that defines the methods required for refresh token data management.
+type RefreshTokenRepository interface {
+	// AddRefreshToken adds a new refresh token to the database.
+	AddRefreshToken(ctx context.Context, refreshToken *model.RefreshToken) (addedRefreshToken *model.RefreshToken, err error)
+
+	// GetRefreshTokenByHash retrieves a refresh token from the database by its hash.
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (refreshToken *model.RefreshToken, err error)
+
+	// UseRefreshToken marks the refresh token with the given ID as used.
+	// It reports whether the refresh token has been marked, which is not the case if it has already been used.
+	UseRefreshToken(ctx context.Context, refreshTokenID int) (used bool, err error)
+
+	// RevokeRefreshTokenFamily revokes all refresh tokens of the family with the given ID.
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) (err error)
+}
+
+// RefreshTokenRepositoryImpl implements the RefreshTokenRepository interface.
+type RefreshTokenRepositoryImpl struct {
+	db database.Database
+}
+
+// NewRefreshTokenRepository creates a new RefreshTokenRepositoryImpl instance with the provided database.
+func NewRefreshTokenRepository(db database.Database) *RefreshTokenRepositoryImpl {
+	return &RefreshTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rr *RefreshTokenRepositoryImpl) AddRefreshToken(ctx context.Context, refreshToken *model.RefreshToken) (*model.RefreshToken, error) {
+	query := `
+		INSERT INTO refresh_tokens (family_id, user_id, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	row, err := rr.db.QueryRowContext(ctx, query, refreshToken.FamilyID, refreshToken.UserID, refreshToken.TokenHash, refreshToken.CreatedAt, refreshToken.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add refresh token: %w", err)
+	}
+
+	if err := row.Scan(&refreshToken.ID); err != nil {
+		return nil, fmt.Errorf("failed to add refresh token: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	query := `
+		SELECT id, family_id, user_id, token_hash, created_at, expires_at, used, revoked
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	row, err := rr.db.QueryRowContext(ctx, query, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token by hash: %w", err)
+	}
+
+	var refreshToken model.RefreshToken
+	if err := row.Scan(&refreshToken.ID, &refreshToken.FamilyID, &refreshToken.UserID, &refreshToken.TokenHash, &refreshToken.CreatedAt, &refreshToken.ExpiresAt, &refreshToken.Used, &refreshToken.Revoked); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get refresh token by hash: %w", err)
+	}
+
+	return &refreshToken, nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) UseRefreshToken(ctx context.Context, refreshTokenID int) (bool, error) {
+	// Checking the flag in the same statement makes sure a refresh token is used only once, even by concurrent requests.
+	query := "UPDATE refresh_tokens SET used = true WHERE id = $1 AND NOT used"
+
+	result, err := rr.db.ExecContext(ctx, query, refreshTokenID)
+	if err != nil {
+		return false, fmt.Errorf("failed to use refresh token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to use refresh token: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	query := "UPDATE refresh_tokens SET revoked = true WHERE family_id = $1"
+
+	if _, err := rr.db.ExecContext(ctx, query, familyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
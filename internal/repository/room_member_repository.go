@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// RoomMemberRepository is an interface that defines the methods required for chat room membership data management.
+type RoomMemberRepository interface {
+	// AddRoomMember adds a new member to the database, unless the user is already a member of the chat room.
+	AddRoomMember(ctx context.Context, member *model.RoomMember) (err error)
+
+	// GetRoomMembers retrieves all members of the chat room with the given short code.
+	GetRoomMembers(ctx context.Context, shortCode string) (members []*model.RoomMember, err error)
+
+	// UpdateRole changes the role of the user with the given user name in the chat room with the given short code.
+	// It reports whether the role has been changed, which is not the case if the user is not a member of the chat room.
+	UpdateRole(ctx context.Context, shortCode, userName, role string) (updated bool, err error)
+}
+
+// RoomMemberRepositoryImpl implements the RoomMemberRepository interface.
+type RoomMemberRepositoryImpl struct {
+	db database.Database
+}
+
+// NewRoomMemberRepository creates a new RoomMemberRepositoryImpl instance with the provided database.
+func NewRoomMemberRepository(db database.Database) *RoomMemberRepositoryImpl {
+	return &RoomMemberRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rmr *RoomMemberRepositoryImpl) AddRoomMember(ctx context.Context, member *model.RoomMember) error {
+	query := `
+		INSERT INTO room_members (short_code, user_name, role, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`
+
+	if _, err := rmr.db.ExecContext(ctx, query, member.ShortCode, member.UserName, member.Role, member.CreatedAt); err != nil {
+		return fmt.Errorf("failed to add room member: %w", err)
+	}
+
+	return nil
+}
+
+func (rmr *RoomMemberRepositoryImpl) GetRoomMembers(ctx context.Context, shortCode string) ([]*model.RoomMember, error) {
+	query := "SELECT short_code, user_name, role, created_at FROM room_members WHERE short_code = $1"
+
+	rows, err := rmr.db.QueryContext(ctx, query, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*model.RoomMember{}
+	for rows.Next() {
+		var member model.RoomMember
+		if err := rows.Scan(&member.ShortCode, &member.UserName, &member.Role, &member.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan room member row: %w", err)
+		}
+		members = append(members, &member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return members, nil
+}
+
+func (rmr *RoomMemberRepositoryImpl) UpdateRole(ctx context.Context, shortCode, userName, role string) (bool, error) {
+	query := "UPDATE room_members SET role = $3 WHERE short_code = $1 AND user_name = $2"
+
+	result, err := rmr.db.ExecContext(ctx, query, shortCode, userName, role)
+	if err != nil {
+		return false, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+)
+
+// RoomRepository is an interface that defines the methods required for chat room data management.
+type RoomRepository interface {
+	// AddRoom adds a new chat room to the database.
+	AddRoom(ctx context.Context, room *model.Room) (addedRoom *model.Room, err error)
+
+	// DeleteRoom deletes a chat room from the database by its short code.
+	DeleteRoom(ctx context.Context, shortCode string) (err error)
+
+	// UpdateRoom updates the name, password and description of a chat room in the database by its short code.
+	UpdateRoom(ctx context.Context, room *model.Room) (err error)
+
+	// UpdateOwner changes the owner of a chat room in the database by its short code from the owner to the newOwner.
+	// It reports whether the owner has been changed, which is not the case if the chat room is not owned by the owner anymore.
+	UpdateOwner(ctx context.Context, shortCode, owner, newOwner string) (updated bool, err error)
+
+	// GetRoomByShortCode retrieves a chat room from the database by its short code.
+	GetRoomByShortCode(ctx context.Context, shortCode string) (room *model.Room, err error)
+
+	// GetAllRooms retrieves all chat rooms from the database.
+	GetAllRooms(ctx context.Context) (rooms []*model.Room, err error)
+}
+
+// RoomRepositoryImpl implements the RoomRepository interface.
+type RoomRepositoryImpl struct {
+	db database.Database
+}
+
+// NewRoomRepository creates a new RoomRepositoryImpl instance with the provided database.
+func NewRoomRepository(db database.Database) *RoomRepositoryImpl {
+	return &RoomRepositoryImpl{
+		db: db,
+	}
+}
+
+func (rr *RoomRepositoryImpl) AddRoom(ctx context.Context, room *model.Room) (*model.Room, error) {
+	query := "INSERT INTO rooms (short_code, created_at, name, description, password, owner, slow_consumer_policy, public, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING short_code, created_at, name, description, password, owner, slow_consumer_policy, public, last_sequence, expires_at"
+
+	row, err := rr.db.QueryRowContext(ctx, query, room.ShortCode, room.CreatedAt, room.Name, room.Description, room.Password, room.Owner, room.SlowConsumerPolicy, room.Public, room.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add room: %w", err)
+	}
+
+	if err = row.Scan(&room.ShortCode, &room.CreatedAt, &room.Name, &room.Description, &room.Password, &room.Owner, &room.SlowConsumerPolicy, &room.Public, &room.LastSequence, &room.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("failed to add room: %w", err)
+	}
+
+	return room, nil
+}
+
+func (rr *RoomRepositoryImpl) DeleteRoom(ctx context.Context, shortCode string) error {
+	query := "DELETE FROM rooms WHERE short_code = $1"
+
+	if _, err := rr.db.ExecContext(ctx, query, shortCode); err != nil {
+		return fmt.Errorf("failed to delete room: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RoomRepositoryImpl) UpdateRoom(ctx context.Context, room *model.Room) error {
+	query := "UPDATE rooms SET name = $2, description = $3, password = $4 WHERE short_code = $1"
+
+	if _, err := rr.db.ExecContext(ctx, query, room.ShortCode, room.Name, room.Description, room.Password); err != nil {
+		return fmt.Errorf("failed to update room: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RoomRepositoryImpl) UpdateOwner(ctx context.Context, shortCode, owner, newOwner string) (bool, error) {
+	query := "UPDATE rooms SET owner = $3 WHERE short_code = $1 AND owner = $2"
+
+	result, err := rr.db.ExecContext(ctx, query, shortCode, owner, newOwner)
+	if err != nil {
+		return false, fmt.Errorf("failed to update room owner: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update room owner: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (rr *RoomRepositoryImpl) GetRoomByShortCode(ctx context.Context, shortCode string) (*model.Room, error) {
+	query := "SELECT short_code, created_at, name, description, password, owner, slow_consumer_policy, public, last_sequence, expires_at FROM rooms WHERE short_code = $1"
+
+	row, err := rr.db.QueryRowContext(ctx, query, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room by short code: %w", err)
+	}
+
+	var room model.Room
+	if err = row.Scan(&room.ShortCode, &room.CreatedAt, &room.Name, &room.Description, &room.Password, &room.Owner, &room.SlowConsumerPolicy, &room.Public, &room.LastSequence, &room.ExpiresAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get room by short code: %w", err)
+	}
+
+	return &room, nil
+}
+
+func (rr *RoomRepositoryImpl) GetAllRooms(ctx context.Context) ([]*model.Room, error) {
+	query := "SELECT short_code, created_at, name, description, password, owner, slow_consumer_policy, public, last_sequence, expires_at FROM rooms"
+
+	rows, err := rr.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all rooms: %w", err)
+	}
+	defer rows.Close()
+
+	rooms := []*model.Room{}
+	for rows.Next() {
+		var room model.Room
+		if err := rows.Scan(&room.ShortCode, &room.CreatedAt, &room.Name, &room.Description, &room.Password, &room.Owner, &room.SlowConsumerPolicy, &room.Public, &room.LastSequence, &room.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan room row: %w", err)
+		}
+		rooms = append(rooms, &room)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error in result set: %w", err)
+	}
+
+	return rooms, nil
+}
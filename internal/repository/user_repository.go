@@ -25,6 +25,12 @@ type UserRepository interface {
 
 	// GetAllUsers retrieves all users from the database.
 	GetAllUsers(ctx context.Context) (users []*model.User, err error)
+
+	// AddExternalUser adds a new user to the database, linked to their subject at the external identity provider with the issuer.
+	AddExternalUser(ctx context.Context, user *model.User, issuer, subject string) (addedUser *model.User, err error)
+
+	// GetUserByExternalIdentity retrieves a user from the database by their subject at the external identity provider with the issuer.
+	GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (user *model.User, err error)
 }
 
 // UserRepositoryImpl implements the UserRepository interface.
@@ -154,3 +160,56 @@ func (ur *UserRepositoryImpl) GetAllUsers(ctx context.Context) ([]*model.User, e
 
 	return users, nil
 }
+
+func (ur *UserRepositoryImpl) AddExternalUser(ctx context.Context, user *model.User, issuer, subject string) (*model.User, error) {
+	// The user and their identity are added by a single statement, so that no user is left without the identity they sign in with.
+	query := `
+		WITH new_user AS (
+			INSERT INTO users (created_at, username, password) VALUES ($1, $2, $3)
+			RETURNING id, created_at, role_id
+		), new_identity AS (
+			INSERT INTO user_identities (user_id, issuer, subject)
+			SELECT id, $4, $5 FROM new_user
+		)
+		SELECT nu.id, nu.created_at, r.name
+		FROM new_user nu
+		LEFT JOIN roles r ON nu.role_id = r.id
+	`
+
+	row, err := ur.db.QueryRowContext(ctx, query, user.CreatedAt, user.Username, user.Password, issuer, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add external user: %w", err)
+	}
+
+	if err = row.Scan(&user.ID, &user.CreatedAt, &user.Role); err != nil {
+		return nil, fmt.Errorf("failed to add external user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (ur *UserRepositoryImpl) GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*model.User, error) {
+	query := `
+		SELECT u.id, u.created_at, u.username, u.password, r.name
+		FROM user_identities ui
+		JOIN users u ON ui.user_id = u.id
+		LEFT JOIN roles r ON u.role_id = r.id
+		WHERE ui.issuer = $1 AND ui.subject = $2
+	`
+
+	row, err := ur.db.QueryRowContext(ctx, query, issuer, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by external identity: %w", err)
+	}
+
+	var user model.User
+	if err = row.Scan(&user.ID, &user.CreatedAt, &user.Username, &user.Password, &user.Role); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get user by external identity: %w", err)
+	}
+
+	return &user, nil
+}
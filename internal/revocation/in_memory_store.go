@@ -0,0 +1,65 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// InMemoryStore implements the Store interface, keeping revocations in memory.
+// Revocations are neither shared with other application instances nor kept after a restart.
+type InMemoryStore struct {
+	mu          sync.Mutex
+	revocations map[key]revocation
+}
+
+type key struct {
+	shortCode string
+	userName  string
+}
+
+type revocation struct {
+	revokedAt time.Time
+	expiresAt time.Time
+}
+
+// NewInMemoryStore creates a new InMemoryStore instance.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		revocations: make(map[key]revocation),
+	}
+}
+
+func (s *InMemoryStore) Revoke(ctx context.Context, shortCode, userName string, revokedAt, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, r := range s.revocations {
+		if r.expiresAt.Before(now) {
+			delete(s.revocations, k)
+		}
+	}
+
+	k := key{shortCode: shortCode, userName: userName}
+	// A later revocation covers all chat tokens revoked by an earlier one.
+	if r, ok := s.revocations[k]; ok && r.revokedAt.After(revokedAt) {
+		return nil
+	}
+	s.revocations[k] = revocation{revokedAt: revokedAt, expiresAt: expiresAt}
+
+	return nil
+}
+
+func (s *InMemoryStore) IsRevoked(ctx context.Context, shortCode, userName string, issuedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, k := range []key{{shortCode: shortCode, userName: userName}, {shortCode: shortCode}} {
+		if r, ok := s.revocations[k]; ok && issuedAt.Before(r.revokedAt) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
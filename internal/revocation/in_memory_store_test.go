@@ -0,0 +1,51 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryStore(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	now := time.Now()
+	before, after := now.Add(-time.Second), now.Add(time.Second)
+
+	require.NoError(t, store.Revoke(ctx, "ABC123", "user1", now, now.Add(time.Hour)))
+
+	revoked, err := store.IsRevoked(ctx, "ABC123", "user1", before)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	revoked, err = store.IsRevoked(ctx, "ABC123", "user1", after)
+	require.NoError(t, err)
+	require.False(t, revoked)
+	revoked, err = store.IsRevoked(ctx, "ABC123", "user2", before)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// An earlier revocation does not replace a later one.
+	require.NoError(t, store.Revoke(ctx, "ABC123", "user1", before.Add(-time.Second), now.Add(time.Hour)))
+	revoked, err = store.IsRevoked(ctx, "ABC123", "user1", before)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// Revoking the chat tokens of all users in the chat room.
+	require.NoError(t, store.Revoke(ctx, "ABC123", "", now, now.Add(time.Hour)))
+	revoked, err = store.IsRevoked(ctx, "ABC123", "user2", before)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	revoked, err = store.IsRevoked(ctx, "XYZ789", "user2", before)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// Expired revocations are forgotten.
+	require.NoError(t, store.Revoke(ctx, "XYZ789", "user2", now, now.Add(-time.Second)))
+	require.NoError(t, store.Revoke(ctx, "XYZ789", "user3", now, now.Add(time.Hour)))
+	revoked, err = store.IsRevoked(ctx, "XYZ789", "user2", before)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
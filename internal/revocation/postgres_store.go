@@ -0,0 +1,63 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/database"
+)
+
+// PostgresStore implements the Store interface, keeping revocations in a PostgreSQL table, so that they are shared by multiple application instances sharing a database.
+type PostgresStore struct {
+	db database.Database
+}
+
+// NewPostgresStore creates a new PostgresStore instance with the provided database.
+func NewPostgresStore(db database.Database) *PostgresStore {
+	return &PostgresStore{
+		db: db,
+	}
+}
+
+func (s *PostgresStore) Revoke(ctx context.Context, shortCode, userName string, revokedAt, expiresAt time.Time) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM chat_token_revocations WHERE expires_at < NOW()"); err != nil {
+		return fmt.Errorf("failed to delete expired revocations: %w", err)
+	}
+
+	// A later revocation covers all chat tokens revoked by an earlier one.
+	query := `
+		INSERT INTO chat_token_revocations (short_code, user_name, revoked_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (short_code, user_name) DO UPDATE
+		SET revoked_at = GREATEST(chat_token_revocations.revoked_at, EXCLUDED.revoked_at),
+			expires_at = GREATEST(chat_token_revocations.expires_at, EXCLUDED.expires_at)
+	`
+
+	if _, err := s.db.ExecContext(ctx, query, shortCode, userName, revokedAt, expiresAt); err != nil {
+		return fmt.Errorf("failed to revoke chat tokens: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresStore) IsRevoked(ctx context.Context, shortCode, userName string, issuedAt time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM chat_token_revocations
+			WHERE short_code = $1 AND (user_name = $2 OR user_name = '') AND revoked_at > $3
+		)
+	`
+
+	row, err := s.db.QueryRowContext(ctx, query, shortCode, userName, issuedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to check revocation: %w", err)
+	}
+
+	var revoked bool
+	if err := row.Scan(&revoked); err != nil {
+		return false, fmt.Errorf("failed to check revocation: %w", err)
+	}
+
+	return revoked, nil
+}
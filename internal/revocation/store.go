@@ -0,0 +1,18 @@
+package revocation
+
+import (
+	"context"
+	"time"
+)
+
+// Store is an interface that defines the methods required for keeping track of revoked chat tokens.
+// Chat tokens are revoked per user and chat room, by the time they were issued at.
+type Store interface {
+	// Revoke revokes the chat tokens issued for the user with the given user name in the chat room with the given short code before revokedAt.
+	// If userName is empty, the chat tokens of all users in the chat room are revoked.
+	// The revocation can be forgotten after expiresAt, once all revoked chat tokens have expired.
+	Revoke(ctx context.Context, shortCode, userName string, revokedAt, expiresAt time.Time) error
+
+	// IsRevoked checks if the chat token issued at issuedAt for the user with the given user name in the chat room with the given short code has been revoked.
+	IsRevoked(ctx context.Context, shortCode, userName string, issuedAt time.Time) (bool, error)
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/internal/service"
+	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListAllChatRooms is an admin RPC handler that pages through all chat rooms, both public and private.
+func (s *Server) ListAllChatRooms(ctx context.Context, req *proto.ListChatRoomsRequest) (*proto.ListChatRoomsResponse, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	res, err := listChatRooms(req, s.roomService.ListRooms)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Admin [%s] listed [%d] chat rooms", rpcID, userName, len(res.Rooms)))
+
+	return res, nil
+}
+
+// ForceDeleteChatRoom is an admin RPC handler that deletes any chat room, regardless of its owner.
+func (s *Server) ForceDeleteChatRoom(ctx context.Context, req *proto.DeleteChatRoomRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+
+	if err := s.roomService.ForceDeleteRoom(roomShortCode); err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "deleting chat room")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Admin [%s] deleted chat room with short code [%s]", rpcID, userName, roomShortCode))
+
+	s.revokeChatTokens(rpcID, roomShortCode, "")
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListConnectedUsers is an admin RPC handler that lists the users connected to a chat room through any application instance.
+func (s *Server) ListConnectedUsers(ctx context.Context, req *proto.ListConnectedUsersRequest) (*proto.ListChatRoomUsersResponse, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+
+	users, err := s.roomService.GetRoomUsers(roomShortCode)
+	if err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving users from chat room")
+	}
+
+	resUsers := make([]*proto.User, 0, len(users))
+	for _, user := range users {
+		resUsers = append(resUsers, &proto.User{
+			UserName: user,
+		})
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Admin [%s] listed chat room with short code [%s] users [%v]", rpcID, userName, roomShortCode, users))
+
+	return &proto.ListChatRoomUsersResponse{
+		Users: resUsers,
+	}, nil
+}
+
+// BroadcastAnnouncement is an admin RPC handler that sends an announcement to all users in all chat rooms.
+func (s *Server) BroadcastAnnouncement(ctx context.Context, req *proto.BroadcastAnnouncementRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	if err := s.roomService.BroadcastAnnouncement(userName, req.GetBody()); err != nil {
+		if errors.Is(err, service.ErrInvalidAnnouncement) {
+			return nil, status.Error(codes.InvalidArgument, errMsgInvalidAnnouncement)
+		}
+		if errors.Is(err, service.ErrMessageTooLarge) {
+			return nil, status.Errorf(codes.InvalidArgument, errMsgMessageTooLarge, service.MaxMessageBodySize)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "broadcasting announcement")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Admin [%s] broadcast announcement [%s]", rpcID, userName, req.GetBody()))
+
+	return &emptypb.Empty{}, nil
+}
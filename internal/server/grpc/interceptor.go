@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/MSSkowron/GRPCChatter/internal/model"
 	"github.com/MSSkowron/GRPCChatter/internal/service"
 	"github.com/MSSkowron/GRPCChatter/pkg/logger"
 	"github.com/MSSkowron/GRPCChatter/pkg/wrapper"
@@ -13,21 +15,30 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const (
-	grpcHeaderTokenKey = "token"
+	grpcHeaderTokenKey              = "token"
+	grpcHeaderResumeFromSequenceKey = "resume-from-sequence"
 
 	errMsgMissingHeaders       = "Missing gRPC headers: [%s]. Please include your authentication token in the [%s] gRPC header."
 	errMsgTokenMissing         = "Authentication token missing in gRPC headers. Please include your token in the [%s] gRPC header."
 	errMsgInvalidToken         = "Invalid authentication token. Please provide a valid token."
 	errMsgNoPermissionToAccess = "No permission to access chat room with short code [%s]."
+	errMsgTokenRevoked         = "Authentication token revoked after user [%s] has been removed from the chat room with short code [%s]. Please join the chat room again."
+	errMsgChatTokenRevoked     = "Authentication token revoked after user [%s] has left the chat room with short code [%s] or refreshed the token. Please join the chat room again."
+	errMsgAdminRoleRequired    = "No permission to call [%s]. It requires the [%s] role."
 )
 
+// redactedFields are the fields of requests whose values are not logged.
+var redactedFields = []protoreflect.Name{"room_password"}
+
 func (s *Server) unaryLogInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	id := uuid.New().String()
 
-	logger.Info(fmt.Sprintf("Received Unary RPC [ID: %s] [Method: %s] with [%v]", id, info.FullMethod, req))
+	logger.Info(fmt.Sprintf("Received Unary RPC [ID: %s] [Method: %s] with [%v]", id, info.FullMethod, redact(req)))
 
 	ctx = context.WithValue(ctx, contextKeyRPCID, id)
 
@@ -35,8 +46,8 @@ func (s *Server) unaryLogInterceptor(ctx context.Context, req any, info *grpc.Un
 }
 
 func (s *Server) unaryAuthorizationInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-	if _, exists := s.authorizedChatTokenUnaryMethods[info.FullMethod]; exists {
-		shortCode, userName, err := s.authorizeChatToken(ctx)
+	if checkPresence, exists := s.authorizedChatTokenUnaryMethods[info.FullMethod]; exists {
+		shortCode, userName, err := s.authorizeChatToken(ctx, checkPresence)
 		if err != nil {
 			return nil, err
 		}
@@ -45,12 +56,17 @@ func (s *Server) unaryAuthorizationInterceptor(ctx context.Context, req any, inf
 		ctx = context.WithValue(ctx, contextKeyUserName, userName)
 		return handler(ctx, req)
 	}
-	if _, exists := s.authorizedUserTokenUnaryMethods[info.FullMethod]; exists {
+	_, admin := s.adminUnaryMethods[info.FullMethod]
+	if _, exists := s.authorizedUserTokenUnaryMethods[info.FullMethod]; exists || admin {
 		userID, userName, userRole, err := s.authorizeUserToken(ctx)
 		if err != nil {
 			return nil, err
 		}
 
+		if admin && userRole != model.RoleAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, errMsgAdminRoleRequired, info.FullMethod, model.RoleAdmin)
+		}
+
 		ctx = context.WithValue(ctx, contextKeyUserID, userID)
 		ctx = context.WithValue(ctx, contextKeyUserName, userName)
 		ctx = context.WithValue(ctx, contextKeyUserRole, userRole)
@@ -75,7 +91,10 @@ func (s *Server) streamLogInterceptor(srv any, ss grpc.ServerStream, info *grpc.
 
 func (s *Server) streamAuthorizationInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	if _, exists := s.authorizedChatTokenStreamMethods[info.FullMethod]; exists {
-		shortCode, userName, err := s.authorizeChatToken(ss.Context())
+		// A user resuming an interrupted message stream has already been removed from the chat room.
+		_, resuming, _ := getResumeFromSequence(ss.Context())
+
+		shortCode, userName, err := s.authorizeChatToken(ss.Context(), !resuming)
 		if err != nil {
 			return err
 		}
@@ -107,7 +126,9 @@ func (s *Server) streamAuthorizationInterceptor(srv any, ss grpc.ServerStream, i
 	return handler(srv, ss)
 }
 
-func (s *Server) authorizeChatToken(ctx context.Context) (string, string, error) {
+// authorizeChatToken validates the chat token from the gRPC headers and returns the short code and user name it was issued for.
+// If checkPresence is true, the user must also be present in the chat room.
+func (s *Server) authorizeChatToken(ctx context.Context, checkPresence bool) (string, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return "", "", status.Errorf(codes.Unauthenticated, errMsgMissingHeaders, grpcHeaderTokenKey, grpcHeaderTokenKey)
@@ -145,8 +166,31 @@ func (s *Server) authorizeChatToken(ctx context.Context) (string, string, error)
 		return "", "", status.Errorf(codes.Internal, errMsgInternalServer, "retrieving user name from token")
 	}
 
-	if !s.roomService.RoomExists(shortCode) {
-		return "", "", status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+	if err := s.roomService.CheckUserAccess(shortCode, userName); err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return "", "", status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+		}
+		if errors.Is(err, service.ErrUserKicked) || errors.Is(err, service.ErrUserBanned) {
+			return "", "", status.Errorf(codes.PermissionDenied, errMsgTokenRevoked, userName, shortCode)
+		}
+
+		return "", "", status.Errorf(codes.Internal, errMsgInternalServer, "checking user access to chat room")
+	}
+
+	revoked, err := s.chatTokenService.IsTokenRevoked(userToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidChatToken) {
+			return "", "", status.Error(codes.Unauthenticated, errMsgInvalidToken)
+		}
+
+		return "", "", status.Errorf(codes.Internal, errMsgInternalServer, "checking token revocation")
+	}
+	if revoked {
+		return "", "", status.Errorf(codes.Unauthenticated, errMsgChatTokenRevoked, userName, shortCode)
+	}
+
+	if !checkPresence {
+		return shortCode, userName, nil
 	}
 
 	is, err := s.roomService.IsUserInRoom(shortCode, userName)
@@ -194,7 +238,7 @@ func (s *Server) authorizeUserToken(ctx context.Context) (int, string, string, e
 
 	userName, err := s.userTokenService.GetUserNameFromToken(userToken)
 	if err != nil {
-		if errors.Is(err, service.ErrInvalidChatToken) {
+		if errors.Is(err, service.ErrInvalidUserToken) {
 			return 0, "", "", status.Error(codes.Unauthenticated, errMsgInvalidToken)
 		}
 
@@ -212,3 +256,52 @@ func (s *Server) authorizeUserToken(ctx context.Context) (int, string, string, e
 
 	return userID, userName, userRole, nil
 }
+
+// getResumeFromSequence retrieves the sequence number of the last message seen by the user from the gRPC headers.
+// It reports whether the header is present.
+func getResumeFromSequence(ctx context.Context) (uint64, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+
+	values := md.Get(grpcHeaderResumeFromSequenceKey)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+
+	sequence, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return 0, true, err
+	}
+
+	return sequence, true, nil
+}
+
+// redact returns the request to be logged, with the values of its redactedFields replaced.
+// The request itself is left intact, since it is still to be handled.
+func redact(req any) any {
+	msg, ok := req.(protov2.Message)
+	if !ok {
+		return req
+	}
+
+	var redacted protoreflect.Message
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for _, name := range redactedFields {
+		field := fields.ByName(name)
+		if field == nil || field.Kind() != protoreflect.StringKind || !msg.ProtoReflect().Has(field) {
+			continue
+		}
+
+		if redacted == nil {
+			redacted = protov2.Clone(msg).ProtoReflect()
+		}
+		redacted.Set(field, protoreflect.ValueOfString("[REDACTED]"))
+	}
+
+	if redacted == nil {
+		return req
+	}
+	return redacted.Interface()
+}
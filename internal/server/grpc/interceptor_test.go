@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/service"
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
+	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRedact(t *testing.T) {
+	newPassword := "n3wP@ssword"
+	req := &proto.UpdateChatRoomRequest{ShortCode: "ABC123", RoomPassword: &newPassword}
+
+	logged := fmt.Sprintf("%v", redact(req))
+	require.NotContains(t, logged, newPassword)
+	require.Contains(t, logged, "[REDACTED]")
+	require.Contains(t, logged, "ABC123")
+
+	// The handled request keeps the password.
+	require.Equal(t, newPassword, req.GetRoomPassword())
+
+	joinReq := &proto.JoinChatRoomRequest{ShortCode: "ABC123", RoomPassword: "secret123"}
+	require.NotContains(t, fmt.Sprintf("%v", redact(joinReq)), "secret123")
+
+	// Requests without a password are logged as they are.
+	deleteReq := &proto.DeleteChatRoomRequest{ShortCode: "ABC123"}
+	require.Same(t, deleteReq, redact(deleteReq))
+	require.NotContains(t, fmt.Sprintf("%v", redact(&proto.UpdateChatRoomRequest{ShortCode: "ABC123"})), "[REDACTED]")
+}
+
+func TestAuthorizeUserToken(t *testing.T) {
+	keySet := token.NewHMACKeySet("testsecret123")
+	issuer := token.NewIssuer("grpcchatter", "grpcchatter-users", keySet)
+	s := NewServer(nil, service.NewUserTokenService(issuer, time.Hour), nil, nil)
+
+	authorize := func(userToken string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcHeaderTokenKey, userToken))
+		_, _, _, err := s.authorizeUserToken(ctx)
+		return err
+	}
+
+	validToken, err := service.NewUserTokenService(issuer, time.Hour).GenerateToken(1, "MSSkowron", "USER")
+	require.NoError(t, err)
+	require.NoError(t, authorize(validToken))
+
+	expiredToken, err := service.NewUserTokenService(issuer, -time.Minute).GenerateToken(1, "MSSkowron", "USER")
+	require.NoError(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(authorize(expiredToken)))
+
+	otherAudienceToken, err := service.NewUserTokenService(token.NewIssuer("grpcchatter", "grpcchatter-chat", keySet), time.Hour).GenerateToken(1, "MSSkowron", "USER")
+	require.NoError(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(authorize(otherAudienceToken)))
+
+	require.Equal(t, codes.Unauthenticated, status.Code(authorize("invalidtoken")))
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/MSSkowron/GRPCChatter/internal/service"
 	"github.com/MSSkowron/GRPCChatter/pkg/logger"
 	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type contextKey string
@@ -24,6 +29,14 @@ const (
 	DefaultPort = 5000
 	// DefaultAddress is the default address the server listens on.
 	DefaultAddress = ""
+	// DefaultChatHistoryLimit is the default number of messages returned by GetChatHistory.
+	DefaultChatHistoryLimit = 50
+	// MaxChatHistoryLimit is the maximum number of messages returned by GetChatHistory.
+	MaxChatHistoryLimit = 100
+	// DefaultChatRoomsLimit is the default number of chat rooms returned by ListChatRooms.
+	DefaultChatRoomsLimit = 50
+	// MaxChatRoomsLimit is the maximum number of chat rooms returned by ListChatRooms.
+	MaxChatRoomsLimit = 100
 
 	contextKeyRPCID     = contextKey("rpcID")
 	contextKeyShortCode = contextKey("shortCode")
@@ -36,11 +49,40 @@ const (
 	errMsgNoPermissionToModify    = "No permission to modify chat room with short code [%s]."
 	errMsgInvalidChatRoomPassword = "Invalid chat room with short code [%s] password. Please make sure you have the correct password."
 	errMsgJoinRoomUserExists      = "User with username [%s] already exists in the chat room with short code [%s]."
+	errMsgInvalidChatHistoryQuery = "Invalid chat history query. Cursor must not be negative and limit must be between 0 and %d."
+	errMsgInvalidChatRoomsQuery   = "Invalid chat rooms query. Limit must be between 0 and %d."
+	errMsgInvalidChatRoomName     = "Invalid chat room name. It must not be empty."
+	errMsgInvalidResumeSequence   = "Invalid [%s] gRPC header. Please provide the sequence number of the last received message."
+	errMsgResumeUserInRoom        = "User with username [%s] is still connected to the chat room with short code [%s]. Please try again later."
+	errMsgSlowConsumer            = "Disconnected for not keeping up with messages."
+	errMsgKicked                  = "Kicked from the chat room by its owner or a moderator."
+	errMsgBanned                  = "Banned from the chat room by its owner."
+	errMsgUserNotInRoom           = "User with username [%s] is not in the chat room with short code [%s]."
+	errMsgUserBanned              = "User with username [%s] is banned from the chat room with short code [%s]."
+	errMsgUserNotBanned           = "User with username [%s] is not banned from the chat room with short code [%s]."
+	errMsgCannotRemoveOwner       = "The owner of the chat room cannot be kicked or banned."
+	errMsgInsufficientRole        = "Your role in the chat room with short code [%s] does not permit this operation."
+	errMsgUserNotMember           = "User with username [%s] is not a member of the chat room with short code [%s]."
+	errMsgOwnerRole               = "The role of the owner of the chat room cannot be changed."
+	errMsgAlreadyOwner            = "User with username [%s] is already the owner of the chat room with short code [%s]."
+	errMsgInvalidSlowConsumer     = "Invalid slow consumer policy [%d]."
+	errMsgInvalidChatRoomTTL      = "Invalid chat room time to live. It must be positive."
+	errMsgRecipientNotFound       = "User with username [%s] is not in the chat room."
+	errMsgInvalidRecipient        = "Cannot send a private message to yourself."
+	errMsgMessageNotFound         = "Message with ID [%s] not found in the chat room."
+	errMsgNoPermissionToMessage   = "No permission to modify message with ID [%s]. Only its sender can edit it, while the chat room owner and moderators can also delete it."
+	errMsgInvalidReaction         = "Invalid reaction. It must be between 1 and %d characters long."
+	errMsgInvalidReadSequence     = "Invalid read sequence. No message with this sequence number has been sent in the chat room yet."
+	errMsgReadOnly                = "No permission to send messages or reactions. You are read-only in the chat room."
+	errMsgInvalidAnnouncement     = "Invalid announcement. It must not be empty."
+	errMsgMessageTooLarge         = "Message too large. Its body must not exceed %d bytes."
 )
 
 // Server represents a gRPC server.
+// Besides the GRPCChatter service, it serves the GRPCChatterAdmin service, whose methods only users with the ADMIN role can call.
 type Server struct {
 	proto.UnimplementedGRPCChatterServer
+	proto.UnimplementedGRPCChatterAdminServer
 
 	chatTokenService service.ChatTokenService
 	userTokenService service.UserTokenService
@@ -50,10 +92,13 @@ type Server struct {
 	address string
 	port    int
 
-	authorizedUserTokenUnaryMethods  map[string]struct{}
-	authorizedChatTokenUnaryMethods  map[string]struct{}
+	authorizedUserTokenUnaryMethods map[string]struct{}
+	// authorizedChatTokenUnaryMethods holds the methods authorized with the chat token, together with whether the user must be present in the chat room.
+	authorizedChatTokenUnaryMethods  map[string]bool
 	authorizedUserTokenStreamMethods map[string]struct{}
 	authorizedChatTokenStreamMethods map[string]struct{}
+	// adminUnaryMethods holds the methods authorized with the user token, which require the ADMIN role.
+	adminUnaryMethods map[string]struct{}
 }
 
 // NewServer creates a new GRPCChatter server.
@@ -66,17 +111,40 @@ func NewServer(chatTokenService service.ChatTokenService, userTokenService servi
 		address:          DefaultAddress,
 		port:             DefaultPort,
 		authorizedUserTokenUnaryMethods: map[string]struct{}{
-			"/proto.GRPCChatter/CreateChatRoom": {},
-			"/proto.GRPCChatter/DeleteChatRoom": {},
-			"/proto.GRPCChatter/JoinChatRoom":   {},
+			"/proto.GRPCChatter/CreateChatRoom":            {},
+			"/proto.GRPCChatter/ListChatRooms":             {},
+			"/proto.GRPCChatter/GetChatRoomInfo":           {},
+			"/proto.GRPCChatter/UpdateChatRoom":            {},
+			"/proto.GRPCChatter/DeleteChatRoom":            {},
+			"/proto.GRPCChatter/KickUser":                  {},
+			"/proto.GRPCChatter/TransferChatRoomOwnership": {},
+			"/proto.GRPCChatter/BanUser":                   {},
+			"/proto.GRPCChatter/UnbanUser":                 {},
+			"/proto.GRPCChatter/PromoteUser":               {},
+			"/proto.GRPCChatter/DemoteUser":                {},
+			"/proto.GRPCChatter/MuteUser":                  {},
+			"/proto.GRPCChatter/UnmuteUser":                {},
+			"/proto.GRPCChatter/JoinChatRoom":              {},
+			"/proto.GRPCChatter/GetUnreadCount":            {},
 		},
-		authorizedChatTokenUnaryMethods: map[string]struct{}{
-			"/proto.GRPCChatter/ListChatRoomUsers": {},
+		authorizedChatTokenUnaryMethods: map[string]bool{
+			// A user resuming an interrupted message stream has already been removed from the chat room, but may need to refresh the token first.
+			"/proto.GRPCChatter/RefreshChatToken":  false,
+			"/proto.GRPCChatter/ListChatRoomUsers": true,
+			"/proto.GRPCChatter/GetChatHistory":    true,
+			"/proto.GRPCChatter/EditMessage":       true,
+			"/proto.GRPCChatter/DeleteMessage":     true,
 		},
 		authorizedUserTokenStreamMethods: map[string]struct{}{},
 		authorizedChatTokenStreamMethods: map[string]struct{}{
 			"/proto.GRPCChatter/Chat": {},
 		},
+		adminUnaryMethods: map[string]struct{}{
+			"/proto.GRPCChatterAdmin/ListAllChatRooms":      {},
+			"/proto.GRPCChatterAdmin/ForceDeleteChatRoom":   {},
+			"/proto.GRPCChatterAdmin/ListConnectedUsers":    {},
+			"/proto.GRPCChatterAdmin/BroadcastAnnouncement": {},
+		},
 	}
 
 	for _, opt := range opts {
@@ -115,6 +183,7 @@ func (s *Server) ListenAndServe() error {
 		grpc.ChainStreamInterceptor(s.streamLogInterceptor, s.streamAuthorizationInterceptor),
 	)
 	proto.RegisterGRPCChatterServer(grpcServer, s)
+	proto.RegisterGRPCChatterAdminServer(grpcServer, s)
 
 	if err := grpcServer.Serve(ln); err != nil {
 		return fmt.Errorf("failed to run grpc server on %s:%d: %w", s.address, s.port, err)
@@ -130,9 +199,26 @@ func (s *Server) CreateChatRoom(ctx context.Context, req *proto.CreateChatRoomRe
 	roomName := req.GetRoomName()
 	roomPassword := req.GetRoomPassword()
 
+	roomSettings := service.RoomSettings{
+		SlowConsumerPolicy: service.SlowConsumerPolicy(req.GetSlowConsumerPolicy()),
+		Public:             req.GetPublic(),
+	}
+	if req.Ttl != nil {
+		if roomSettings.TTL = req.GetTtl().AsDuration(); roomSettings.TTL <= 0 {
+			return nil, status.Error(codes.InvalidArgument, errMsgInvalidChatRoomTTL)
+		}
+	}
+
 	roomShortCode := s.shortCodeService.GenerateShortCode(roomName)
 
-	if err := s.roomService.CreateRoom(roomShortCode, roomName, roomPassword, userName); err != nil {
+	if err := s.roomService.CreateRoom(roomShortCode, roomName, roomPassword, userName, roomSettings); err != nil {
+		if errors.Is(err, service.ErrInvalidSlowConsumerPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, errMsgInvalidSlowConsumer, req.GetSlowConsumerPolicy())
+		}
+		if errors.Is(err, service.ErrInvalidRoomTTL) {
+			return nil, status.Error(codes.InvalidArgument, errMsgInvalidChatRoomTTL)
+		}
+
 		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "creating chat room")
 	}
 
@@ -143,6 +229,121 @@ func (s *Server) CreateChatRoom(ctx context.Context, req *proto.CreateChatRoomRe
 	}, nil
 }
 
+// ListChatRooms is an RPC handler that pages through the public chat rooms.
+func (s *Server) ListChatRooms(ctx context.Context, req *proto.ListChatRoomsRequest) (*proto.ListChatRoomsResponse, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	res, err := listChatRooms(req, s.roomService.ListPublicRooms)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] listed [%d] public chat rooms", rpcID, userName, len(res.Rooms)))
+
+	return res, nil
+}
+
+// listChatRooms returns the page of chat rooms requested by the request, which are listed with the list function.
+func listChatRooms(req *proto.ListChatRoomsRequest, list func(nameFilter, cursor string, limit int) ([]*service.RoomInfo, error)) (*proto.ListChatRoomsResponse, error) {
+	limit := req.GetLimit()
+	if limit < 0 || limit > MaxChatRoomsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, errMsgInvalidChatRoomsQuery, MaxChatRoomsLimit)
+	}
+	if limit == 0 {
+		limit = DefaultChatRoomsLimit
+	}
+
+	rooms, err := list(req.GetNameFilter(), req.GetCursor(), int(limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "listing chat rooms")
+	}
+
+	resRooms := make([]*proto.ChatRoom, 0, len(rooms))
+	for _, room := range rooms {
+		resRooms = append(resRooms, &proto.ChatRoom{
+			ShortCode:   room.ShortCode,
+			Name:        room.Name,
+			Owner:       room.Owner,
+			MemberCount: int32(room.MemberCount),
+			CreatedAt:   timestamppb.New(room.CreatedAt),
+			Public:      room.Settings.Public,
+		})
+	}
+
+	var nextCursor string
+	if len(rooms) == int(limit) {
+		nextCursor = rooms[len(rooms)-1].ShortCode
+	}
+
+	return &proto.ListChatRoomsResponse{
+		Rooms:      resRooms,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetChatRoomInfo is an RPC handler that retrieves the information about a chat room.
+func (s *Server) GetChatRoomInfo(ctx context.Context, req *proto.GetChatRoomInfoRequest) (*proto.GetChatRoomInfoResponse, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+
+	room, err := s.roomService.GetRoomInfo(roomShortCode)
+	if err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		}
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving chat room info")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] retrieved info of room with short code [%s]", rpcID, userName, roomShortCode))
+
+	resp := &proto.GetChatRoomInfoResponse{
+		ShortCode:          room.ShortCode,
+		Name:               room.Name,
+		Description:        room.Description,
+		Owner:              room.Owner,
+		CreatedAt:          timestamppb.New(room.CreatedAt),
+		MemberCount:        int32(room.MemberCount),
+		SlowConsumerPolicy: proto.SlowConsumerPolicy(room.Settings.SlowConsumerPolicy),
+		Public:             room.Settings.Public,
+	}
+	if !room.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(room.ExpiresAt)
+	}
+
+	return resp, nil
+}
+
+// UpdateChatRoom is an RPC handler that changes the name, password or description of a chat room.
+func (s *Server) UpdateChatRoom(ctx context.Context, req *proto.UpdateChatRoomRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+
+	update := service.RoomUpdate{
+		Name:        req.RoomName,
+		Password:    req.RoomPassword,
+		Description: req.Description,
+	}
+
+	if err := s.roomService.UpdateRoom(roomShortCode, userName, update); err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		}
+		if errors.Is(err, service.ErrNotOwner) {
+			return nil, status.Errorf(codes.PermissionDenied, errMsgNoPermissionToModify, roomShortCode)
+		}
+		if errors.Is(err, service.ErrInvalidRoomName) {
+			return nil, status.Error(codes.InvalidArgument, errMsgInvalidChatRoomName)
+		}
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "updating chat room")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] updated room with short code [%s]", rpcID, userName, roomShortCode))
+
+	return &emptypb.Empty{}, nil
+}
+
 // DeleteChatRoom is an RPC handler that deletes a chat room.
 func (s *Server) DeleteChatRoom(ctx context.Context, req *proto.DeleteChatRoomRequest) (*emptypb.Empty, error) {
 	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
@@ -161,6 +362,156 @@ func (s *Server) DeleteChatRoom(ctx context.Context, req *proto.DeleteChatRoomRe
 
 	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] deleted room with short code [%s]", rpcID, userName, roomShortCode))
 
+	s.revokeChatTokens(rpcID, roomShortCode, "")
+
+	return &emptypb.Empty{}, nil
+}
+
+// TransferChatRoomOwnership is an RPC handler that makes a member of a chat room its owner in place of the user calling the RPC.
+func (s *Server) TransferChatRoomOwnership(ctx context.Context, req *proto.TransferChatRoomOwnershipRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+	newOwner := req.GetUserName()
+
+	if err := s.roomService.TransferOwnership(roomShortCode, userName, newOwner); err != nil {
+		switch {
+		case errors.Is(err, service.ErrRoomDoesNotExist):
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		case errors.Is(err, service.ErrNotOwner):
+			return nil, status.Errorf(codes.PermissionDenied, errMsgNoPermissionToModify, roomShortCode)
+		case errors.Is(err, service.ErrAlreadyOwner):
+			return nil, status.Errorf(codes.InvalidArgument, errMsgAlreadyOwner, newOwner, roomShortCode)
+		case errors.Is(err, service.ErrUserBanned):
+			return nil, status.Errorf(codes.FailedPrecondition, errMsgUserBanned, newOwner, roomShortCode)
+		case errors.Is(err, service.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, errMsgUserNotMember, newOwner, roomShortCode)
+		default:
+			return nil, status.Errorf(codes.Internal, errMsgInternalServer, "transferring chat room ownership")
+		}
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] transferred ownership of room with short code [%s] to user [%s]", rpcID, userName, roomShortCode, newOwner))
+
+	return &emptypb.Empty{}, nil
+}
+
+// KickUser is an RPC handler that removes a user from a chat room.
+func (s *Server) KickUser(ctx context.Context, req *proto.KickUserRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+	target := req.GetUserName()
+
+	if err := s.roomService.KickUser(roomShortCode, userName, target); err != nil {
+		return nil, removalError(err, roomShortCode, target, "kicking user")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] kicked user [%s] from room with short code [%s]", rpcID, userName, target, roomShortCode))
+
+	s.revokeChatTokens(rpcID, roomShortCode, target)
+
+	return &emptypb.Empty{}, nil
+}
+
+// BanUser is an RPC handler that removes a user from a chat room and prevents them from joining it again.
+func (s *Server) BanUser(ctx context.Context, req *proto.BanUserRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+	target := req.GetUserName()
+
+	if err := s.roomService.BanUser(roomShortCode, userName, target); err != nil {
+		return nil, removalError(err, roomShortCode, target, "banning user")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] banned user [%s] from room with short code [%s]", rpcID, userName, target, roomShortCode))
+
+	s.revokeChatTokens(rpcID, roomShortCode, target)
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnbanUser is an RPC handler that lets a banned user join a chat room again.
+func (s *Server) UnbanUser(ctx context.Context, req *proto.UnbanUserRequest) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	roomShortCode := req.GetShortCode()
+	target := req.GetUserName()
+
+	if err := s.roomService.UnbanUser(roomShortCode, userName, target); err != nil {
+		if errors.Is(err, service.ErrUserNotBanned) {
+			return nil, status.Errorf(codes.NotFound, errMsgUserNotBanned, target, roomShortCode)
+		}
+		return nil, removalError(err, roomShortCode, target, "unbanning user")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] unbanned user [%s] from room with short code [%s]", rpcID, userName, target, roomShortCode))
+
+	return &emptypb.Empty{}, nil
+}
+
+// removalError converts the error returned while kicking, banning or unbanning the target user into a gRPC status error.
+func removalError(err error, roomShortCode, target, action string) error {
+	switch {
+	case errors.Is(err, service.ErrRoomDoesNotExist):
+		return status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+	case errors.Is(err, service.ErrNotOwner):
+		return status.Errorf(codes.PermissionDenied, errMsgNoPermissionToModify, roomShortCode)
+	case errors.Is(err, service.ErrInsufficientRole):
+		return status.Errorf(codes.PermissionDenied, errMsgInsufficientRole, roomShortCode)
+	case errors.Is(err, service.ErrCannotRemoveOwner):
+		return status.Error(codes.InvalidArgument, errMsgCannotRemoveOwner)
+	case errors.Is(err, service.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, errMsgUserNotInRoom, target, roomShortCode)
+	default:
+		return status.Errorf(codes.Internal, errMsgInternalServer, action)
+	}
+}
+
+// PromoteUser is an RPC handler that makes a member of a chat room its moderator.
+func (s *Server) PromoteUser(ctx context.Context, req *proto.PromoteUserRequest) (*emptypb.Empty, error) {
+	return s.setUserRole(ctx, req.GetShortCode(), req.GetUserName(), service.RoomRoleModerator, "promoting user")
+}
+
+// DemoteUser is an RPC handler that makes a moderator of a chat room its regular member.
+func (s *Server) DemoteUser(ctx context.Context, req *proto.DemoteUserRequest) (*emptypb.Empty, error) {
+	return s.setUserRole(ctx, req.GetShortCode(), req.GetUserName(), service.RoomRoleMember, "demoting user")
+}
+
+// MuteUser is an RPC handler that makes a member of a chat room read-only.
+func (s *Server) MuteUser(ctx context.Context, req *proto.MuteUserRequest) (*emptypb.Empty, error) {
+	return s.setUserRole(ctx, req.GetShortCode(), req.GetUserName(), service.RoomRoleReadOnly, "muting user")
+}
+
+// UnmuteUser is an RPC handler that lets a read-only member of a chat room send messages again.
+func (s *Server) UnmuteUser(ctx context.Context, req *proto.UnmuteUserRequest) (*emptypb.Empty, error) {
+	return s.setUserRole(ctx, req.GetShortCode(), req.GetUserName(), service.RoomRoleMember, "unmuting user")
+}
+
+// setUserRole changes the role of the target user in the chat room on behalf of the user calling the RPC.
+func (s *Server) setUserRole(ctx context.Context, roomShortCode, target string, role service.RoomRole, action string) (*emptypb.Empty, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	if err := s.roomService.SetUserRole(roomShortCode, userName, target, role); err != nil {
+		switch {
+		case errors.Is(err, service.ErrRoomDoesNotExist):
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		case errors.Is(err, service.ErrNotOwner):
+			return nil, status.Errorf(codes.PermissionDenied, errMsgNoPermissionToModify, roomShortCode)
+		case errors.Is(err, service.ErrInsufficientRole):
+			return nil, status.Errorf(codes.PermissionDenied, errMsgInsufficientRole, roomShortCode)
+		case errors.Is(err, service.ErrOwnerRole):
+			return nil, status.Error(codes.InvalidArgument, errMsgOwnerRole)
+		case errors.Is(err, service.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, errMsgUserNotMember, target, roomShortCode)
+		default:
+			return nil, status.Errorf(codes.Internal, errMsgInternalServer, action)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] changed role of user [%s] in room with short code [%s] to [%s]", rpcID, userName, target, roomShortCode, role))
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -193,24 +544,95 @@ func (s *Server) JoinChatRoom(ctx context.Context, req *proto.JoinChatRoomReques
 		if errors.Is(err, service.ErrUserAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, errMsgJoinRoomUserExists, userName, roomShortCode)
 		}
+		if errors.Is(err, service.ErrUserBanned) {
+			return nil, status.Errorf(codes.PermissionDenied, errMsgUserBanned, userName, roomShortCode)
+		}
 
 		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "adding user to chat room")
 	}
 
 	logger.Info(fmt.Sprintf("[ID: %s]: Added user [%s] to chat room user's list with short code [%s]", rpcID, userName, roomShortCode))
 
-	token, err := s.chatTokenService.GenerateToken(userName, roomShortCode)
+	role, err := s.roomService.GetUserRole(roomShortCode, userName)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "generating token")
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving user's role")
+	}
+
+	token, expiresAt, err := s.generateChatToken(userName, roomShortCode, role)
+	if err != nil {
+		return nil, err
 	}
 
-	logger.Info(fmt.Sprintf("[ID: %s]: Generated token [%s] for user [%s] to chat room with short code [%s]", rpcID, token, userName, roomShortCode))
+	logger.Info(fmt.Sprintf("[ID: %s]: Generated token for user [%s] to chat room with short code [%s] valid until [%s]", rpcID, userName, roomShortCode, expiresAt))
 
 	return &proto.JoinChatRoomResponse{
-		Token: token,
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// RefreshChatToken is an RPC handler that replaces the chat token, which is still valid, with a new one, revoking the chat tokens previously issued to the user for the chat room.
+// The new token carries the user's current role in the chat room.
+func (s *Server) RefreshChatToken(ctx context.Context, req *emptypb.Empty) (*proto.RefreshChatTokenResponse, error) {
+	rpcID, shortCode, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyShortCode).(string), ctx.Value(contextKeyUserName).(string)
+
+	role, err := s.roomService.GetUserRole(shortCode, userName)
+	if err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving user's role")
+	}
+
+	// Revoking the previous tokens before generating the new one makes only the new one valid.
+	if err := s.chatTokenService.RevokeTokens(userName, shortCode); err != nil {
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "revoking previous token")
+	}
+
+	token, expiresAt, err := s.generateChatToken(userName, shortCode, role)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Refreshed token for user [%s] to chat room with short code [%s] valid until [%s]", rpcID, userName, shortCode, expiresAt))
+
+	return &proto.RefreshChatTokenResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
 	}, nil
 }
 
+// generateChatToken generates a chat token for the user to the chat room and returns it together with the time it expires at.
+func (s *Server) generateChatToken(userName, roomShortCode string, role service.RoomRole) (string, time.Time, error) {
+	token, err := s.chatTokenService.GenerateToken(userName, roomShortCode, role)
+	if err != nil {
+		return "", time.Time{}, status.Errorf(codes.Internal, errMsgInternalServer, "generating token")
+	}
+
+	expiresAt, err := s.chatTokenService.GetExpirationFromToken(token)
+	if err != nil {
+		return "", time.Time{}, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving token expiration")
+	}
+
+	return token, expiresAt, nil
+}
+
+// revokeChatTokens revokes the chat tokens issued so far to the user, or all users if userName is empty, for the chat room.
+// The user has already been removed from the chat room, so a failure is only logged.
+func (s *Server) revokeChatTokens(rpcID, roomShortCode, userName string) {
+	if err := s.chatTokenService.RevokeTokens(userName, roomShortCode); err != nil {
+		logger.Error(fmt.Sprintf("[ID: %s]: Failed to revoke tokens of user [%s] to chat room with short code [%s]: %s", rpcID, userName, roomShortCode, err))
+		return
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: Revoked tokens of user [%s] to chat room with short code [%s]", rpcID, userName, roomShortCode))
+}
+
 // ListChatRoomUsers is an RPC handler that lists the users in a chat room.
 func (s *Server) ListChatRoomUsers(ctx context.Context, req *emptypb.Empty) (*proto.ListChatRoomUsersResponse, error) {
 	rpcID, shortCode, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyShortCode).(string), ctx.Value(contextKeyUserName).(string)
@@ -240,102 +662,497 @@ func (s *Server) ListChatRoomUsers(ctx context.Context, req *emptypb.Empty) (*pr
 	}, nil
 }
 
-// Chat is a server-side streaming RPC handler that receives messages from users and broadcasts them to all other users.
+// GetChatHistory is an RPC handler that pages backwards through the messages previously sent in a chat room.
+func (s *Server) GetChatHistory(ctx context.Context, req *proto.GetChatHistoryRequest) (*proto.GetChatHistoryResponse, error) {
+	rpcID, shortCode, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyShortCode).(string), ctx.Value(contextKeyUserName).(string)
+
+	cursor := req.GetCursor()
+	limit := req.GetLimit()
+
+	if cursor < 0 || limit < 0 || limit > MaxChatHistoryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, errMsgInvalidChatHistoryQuery, MaxChatHistoryLimit)
+	}
+	if limit == 0 {
+		limit = DefaultChatHistoryLimit
+	}
+
+	messages, err := s.roomService.GetChatHistory(shortCode, userName, int(cursor), int(limit))
+	if err != nil {
+		if errors.Is(err, service.ErrRoomDoesNotExist) {
+			return nil, status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+		}
+
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "retrieving chat history")
+	}
+
+	resMessages := make([]*proto.HistoryMessage, 0, len(messages))
+	for _, message := range messages {
+		resMessage := &proto.HistoryMessage{
+			Id:        int64(message.ID),
+			UserName:  message.Sender,
+			Body:      message.Body,
+			CreatedAt: timestamppb.New(message.CreatedAt),
+			MessageId: message.MessageID,
+			Sequence:  message.Sequence,
+			Recipient: message.Recipient,
+			ReplyTo:   message.ReplyTo,
+		}
+		if message.EditedAt != nil {
+			resMessage.EditedAt = timestamppb.New(*message.EditedAt)
+		}
+		for emoji, count := range message.Reactions {
+			resMessage.Reactions = append(resMessage.Reactions, &proto.ReactionCount{Emoji: emoji, Count: int32(count)})
+		}
+		sort.Slice(resMessage.Reactions, func(i, j int) bool {
+			return resMessage.Reactions[i].Emoji < resMessage.Reactions[j].Emoji
+		})
+		resMessages = append(resMessages, resMessage)
+	}
+
+	var nextCursor int64
+	if len(messages) == int(limit) {
+		nextCursor = int64(messages[len(messages)-1].ID)
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] retrieved [%d] messages from chat room with short code [%s] history", rpcID, userName, len(resMessages), shortCode))
+
+	return &proto.GetChatHistoryResponse{
+		Messages:   resMessages,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetUnreadCount is an RPC handler that counts the unread messages in each chat room the user is a member of.
+func (s *Server) GetUnreadCount(ctx context.Context, req *emptypb.Empty) (*proto.GetUnreadCountResponse, error) {
+	rpcID, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyUserName).(string)
+
+	unreadCounts, err := s.roomService.GetUnreadCounts(userName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, errMsgInternalServer, "counting unread messages")
+	}
+
+	resRooms := make([]*proto.RoomUnreadCount, 0, len(unreadCounts))
+	for _, unreadCount := range unreadCounts {
+		resRooms = append(resRooms, &proto.RoomUnreadCount{
+			ShortCode:        unreadCount.ShortCode,
+			RoomName:         unreadCount.RoomName,
+			LastReadSequence: unreadCount.LastReadSequence,
+			UnreadCount:      uint64(unreadCount.Count),
+		})
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] retrieved unread message counts of [%d] chat rooms", rpcID, userName, len(resRooms)))
+
+	return &proto.GetUnreadCountResponse{
+		Rooms: resRooms,
+	}, nil
+}
+
+// EditMessage is an RPC handler that changes the body of a message previously sent in a chat room.
+func (s *Server) EditMessage(ctx context.Context, req *proto.EditMessageRequest) (*emptypb.Empty, error) {
+	rpcID, shortCode, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyShortCode).(string), ctx.Value(contextKeyUserName).(string)
+
+	messageID := req.GetMessageId()
+
+	if err := s.roomService.EditMessage(shortCode, userName, messageID, req.GetBody()); err != nil {
+		return nil, messageModificationError(err, shortCode, messageID, "editing message")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] edited message with ID [%s] in chat room with short code [%s]", rpcID, userName, messageID, shortCode))
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteMessage is an RPC handler that deletes a message previously sent in a chat room.
+func (s *Server) DeleteMessage(ctx context.Context, req *proto.DeleteMessageRequest) (*emptypb.Empty, error) {
+	rpcID, shortCode, userName := ctx.Value(contextKeyRPCID).(string), ctx.Value(contextKeyShortCode).(string), ctx.Value(contextKeyUserName).(string)
+
+	messageID := req.GetMessageId()
+
+	if err := s.roomService.DeleteMessage(shortCode, userName, messageID); err != nil {
+		return nil, messageModificationError(err, shortCode, messageID, "deleting message")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] deleted message with ID [%s] in chat room with short code [%s]", rpcID, userName, messageID, shortCode))
+
+	return &emptypb.Empty{}, nil
+}
+
+// messageModificationError converts the error of editing or deleting a message into a gRPC status error.
+func messageModificationError(err error, shortCode, messageID, action string) error {
+	switch {
+	case errors.Is(err, service.ErrRoomDoesNotExist):
+		return status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+	case errors.Is(err, service.ErrMessageNotFound):
+		return status.Errorf(codes.NotFound, errMsgMessageNotFound, messageID)
+	case errors.Is(err, service.ErrNotMessageSender):
+		return status.Errorf(codes.PermissionDenied, errMsgNoPermissionToMessage, messageID)
+	case errors.Is(err, service.ErrMessageTooLarge):
+		return status.Errorf(codes.InvalidArgument, errMsgMessageTooLarge, service.MaxMessageBodySize)
+	default:
+		return status.Errorf(codes.Internal, errMsgInternalServer, action)
+	}
+}
+
+// Chat is a server-side streaming RPC handler that receives messages from users and broadcasts them to all other users, or privately to their recipients.
 func (s *Server) Chat(chs proto.GRPCChatter_ChatServer) error {
 	rpcID, shortCode, userName := chs.Context().Value(contextKeyRPCID).(string), chs.Context().Value(contextKeyShortCode).(string), chs.Context().Value(contextKeyUserName).(string)
 
-	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] established message stream with the chat room with short code [%s]", rpcID, userName, shortCode))
+	lastSequence, resuming, err := getResumeFromSequence(chs.Context())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, errMsgInvalidResumeSequence, grpcHeaderResumeFromSequenceKey)
+	}
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	if resuming {
+		if err := s.roomService.ResumeUserInRoom(shortCode, userName, lastSequence); err != nil {
+			if errors.Is(err, service.ErrRoomDoesNotExist) {
+				return status.Errorf(codes.NotFound, errMsgChatRoomNotFound, shortCode)
+			}
+			if errors.Is(err, service.ErrUserAlreadyExists) {
+				return status.Errorf(codes.AlreadyExists, errMsgResumeUserInRoom, userName, shortCode)
+			}
+			if errors.Is(err, service.ErrUserKicked) || errors.Is(err, service.ErrUserBanned) {
+				return status.Errorf(codes.PermissionDenied, errMsgTokenRevoked, userName, shortCode)
+			}
+
+			return status.Errorf(codes.Internal, errMsgInternalServer, "resuming user in chat room")
+		}
+
+		logger.Info(fmt.Sprintf("[ID: %s]: Resumed user [%s] in chat room with short code [%s] from sequence [%d]", rpcID, userName, shortCode, lastSequence))
+	}
+
+	// Sending the headers lets the client know that the stream has been established.
+	if err := chs.SendHeader(metadata.MD{}); err != nil {
+		_ = s.roomService.RemoveUserFromRoom(shortCode, userName)
+		return status.Errorf(codes.Internal, errMsgInternalServer, "establishing message stream")
+	}
+
+	logger.Info(fmt.Sprintf("[ID: %s]: User [%s] established message stream with the chat room with short code [%s]", rpcID, userName, shortCode))
 
-	receiveCh := make(chan struct{}, 1)
-	sendCh := make(chan struct{}, 1)
+	// Both goroutines send on the stream, which is not safe for concurrent use.
+	chs = &lockedChatServer{GRPCChatter_ChatServer: chs}
 
-	go s.receive(rpcID, chs, userName, shortCode, sendCh, receiveCh, wg)
-	go s.send(rpcID, chs, userName, shortCode, receiveCh, sendCh, wg)
+	errCh := make(chan error, 2)
+	sendDoneCh := make(chan struct{})
 
-	wg.Wait()
+	go func() {
+		errCh <- s.receive(rpcID, chs, userName, shortCode, sendDoneCh)
+	}()
+	go func() {
+		defer close(sendDoneCh)
+		errCh <- s.send(rpcID, chs, userName, shortCode)
+	}()
 
-	close(receiveCh)
-	close(sendCh)
+	// Returning ends the stream, which in turn stops the other goroutine.
+	err = <-errCh
 
 	logger.Info(fmt.Sprintf("[ID: %s]: Closed message stream with user [%s] and the chat room with short code [%s]", rpcID, userName, shortCode))
 
-	return nil
+	return err
 }
 
-func (s *Server) receive(id string, chs proto.GRPCChatter_ChatServer, userName, roomShortCode string, sendStopCh chan<- struct{}, receiveStopCh <-chan struct{}, wg *sync.WaitGroup) {
-	defer wg.Done()
-
-	for {
+// receive receives messages from the user and broadcasts them to the chat room until the stream ends.
+// Unless the sending side has already stopped, which means the user has been removed, it removes the user from the chat room.
+func (s *Server) receive(id string, chs proto.GRPCChatter_ChatServer, userName, roomShortCode string, sendDoneCh <-chan struct{}) error {
+	defer func() {
 		select {
-		case <-receiveStopCh:
+		case <-sendDoneCh:
 			return
 		default:
-			mssg, err := chs.Recv()
-			if err != nil {
-				if status.Code(err) == codes.Canceled {
-					logger.Info(fmt.Sprintf("[ID: %s]: User [%s] left the chat room with short code [%s]", id, userName, roomShortCode))
+		}
 
-					_ = s.roomService.RemoveUserFromRoom(roomShortCode, userName)
+		_ = s.roomService.RemoveUserFromRoom(roomShortCode, userName)
 
-					logger.Info(fmt.Sprintf("[ID: %s]: Removed user [%s] from chat room user's list with short code [%s]", id, userName, roomShortCode))
+		logger.Info(fmt.Sprintf("[ID: %s]: Removed user [%s] from chat room user's list with short code [%s]", id, userName, roomShortCode))
+	}()
 
-				} else {
-					logger.Error(fmt.Sprintf("[ID: %s]: Failed to receive message from user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
+	for {
+		mssg, err := chs.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				logger.Info(fmt.Sprintf("[ID: %s]: User [%s] left the chat room with short code [%s]", id, userName, roomShortCode))
+
+				// Closing the stream means the user has left on purpose rather than lost the connection, so the stream will not be resumed.
+				s.revokeChatTokens(id, roomShortCode, userName)
+			} else if status.Code(err) == codes.Canceled {
+				logger.Info(fmt.Sprintf("[ID: %s]: User [%s] left the chat room with short code [%s]", id, userName, roomShortCode))
+			} else {
+				logger.Error(fmt.Sprintf("[ID: %s]: Failed to receive message from user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
+			}
+
+			return nil
+		}
+
+		// Typing signals are ephemeral, so they are neither logged nor stored.
+		if typing := mssg.GetTyping(); typing != nil {
+			if err := s.roomService.SetUserTyping(roomShortCode, userName, typing.GetActive()); errors.Is(err, service.ErrRoomDoesNotExist) {
+				return status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+			}
+
+			continue
+		}
+
+		if markRead := mssg.GetMarkRead(); markRead != nil {
+			sequence := markRead.GetSequence()
+
+			if err := s.roomService.MarkMessagesRead(roomShortCode, userName, sequence); err != nil {
+				logger.Error(fmt.Sprintf("[ID: %s]: Failed to mark messages up to sequence [%d] as read by user [%s] in chat room with short code [%s]: %s", id, sequence, userName, roomShortCode, status.Convert(err).Message()))
+
+				if err := s.handleChatError(chs, err, roomShortCode, "", "", "marking messages as read"); err != nil {
+					return err
 				}
 
-				sendStopCh <- struct{}{}
+				continue
+			}
 
-				return
+			logger.Info(fmt.Sprintf("[ID: %s]: User [%s] read messages up to sequence [%d] in chat room with short code [%s]", id, userName, sequence, roomShortCode))
+
+			continue
+		}
+
+		if reaction := mssg.GetReaction(); reaction != nil {
+			messageID, emoji := reaction.GetMessageId(), reaction.GetEmoji()
+
+			var err error
+			if reaction.GetRemove() {
+				logger.Info(fmt.Sprintf("[ID: %s]: Received removal of reaction [%s] to message with ID [%s] from user [%s] in chat room with short code [%s]", id, emoji, messageID, userName, roomShortCode))
+				err = s.roomService.RemoveReaction(roomShortCode, userName, messageID, emoji)
+			} else {
+				logger.Info(fmt.Sprintf("[ID: %s]: Received reaction [%s] to message with ID [%s] from user [%s] in chat room with short code [%s]", id, emoji, messageID, userName, roomShortCode))
+				err = s.roomService.AddReaction(roomShortCode, userName, messageID, emoji)
 			}
+			if err != nil {
+				logger.Error(fmt.Sprintf("[ID: %s]: Failed to react to message with ID [%s] from user [%s] in chat room with short code [%s]: %s", id, messageID, userName, roomShortCode, status.Convert(err).Message()))
 
-			body := mssg.GetBody()
+				if err := s.handleChatError(chs, err, roomShortCode, "", messageID, "reacting to message"); err != nil {
+					return err
+				}
+			}
 
-			logger.Info(fmt.Sprintf("[ID: %s]: Received message [{Body: %s}] from user [%s] in chat room with short code [%s]", id, body, userName, roomShortCode))
+			continue
+		}
+
+		body, recipient, replyTo := mssg.GetBody(), mssg.GetRecipient(), mssg.GetReplyTo()
 
-			if err := s.roomService.BroadcastMessageToRoom(roomShortCode, &service.Message{
-				Sender: userName,
-				Body:   body,
-			}); err != nil {
-				logger.Error(fmt.Sprintf("[ID: %s]: Failed to broadcast message from user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
+		logger.Info(fmt.Sprintf("[ID: %s]: Received message [{Body: %s, Recipient: %s, ReplyTo: %s}] from user [%s] in chat room with short code [%s]", id, body, recipient, replyTo, userName, roomShortCode))
 
-				sendStopCh <- struct{}{}
+		if err := s.roomService.BroadcastMessageToRoom(roomShortCode, &service.Message{
+			Sender:    userName,
+			Body:      body,
+			Recipient: recipient,
+			ReplyTo:   replyTo,
+		}); err != nil {
+			logger.Error(fmt.Sprintf("[ID: %s]: Failed to broadcast message from user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
 
-				return
+			if err := s.handleChatError(chs, err, roomShortCode, recipient, replyTo, "broadcasting message"); err != nil {
+				return err
 			}
 		}
 	}
 }
 
-func (s *Server) send(id string, chs proto.GRPCChatter_ChatServer, userName, roomShortCode string, sendStopCh chan<- struct{}, receiveStopCh <-chan struct{}, wg *sync.WaitGroup) {
-	defer wg.Done()
+// handleChatError tells the user about an error caused by their message without ending the stream.
+// It returns an error ending the stream if the error is not caused by the message itself.
+// A failure to send the error event is ignored, as the broken stream ends the next receive anyway.
+func (s *Server) handleChatError(chs proto.GRPCChatter_ChatServer, err error, roomShortCode, recipient, messageID, action string) error {
+	if chatErr := newChatError(err, recipient, messageID); chatErr != nil {
+		_ = chs.Send(&proto.ServerMessage{
+			Timestamp: timestamppb.Now(),
+			Event:     &proto.ServerMessage_Error{Error: chatErr},
+		})
+
+		return nil
+	}
 
+	if errors.Is(err, service.ErrRoomDoesNotExist) {
+		return status.Errorf(codes.NotFound, errMsgChatRoomNotFound, roomShortCode)
+	}
+	// Read-only users are denied like any other unauthorized call, so their stream ends.
+	if errors.Is(err, service.ErrInsufficientRole) {
+		return status.Error(codes.PermissionDenied, errMsgReadOnly)
+	}
+
+	return status.Errorf(codes.Internal, errMsgInternalServer, action)
+}
+
+// send sends events from the user's message queue until the queue is closed or the stream ends.
+// It makes sure the user is removed from the chat room before returning.
+func (s *Server) send(id string, chs proto.GRPCChatter_ChatServer, userName, roomShortCode string) error {
 	for {
-		select {
-		case <-receiveStopCh:
-			return
-		default:
-			msg, err := s.roomService.GetUserMessage(roomShortCode, userName)
-			if err != nil {
-				sendStopCh <- struct{}{}
+		event, err := s.roomService.GetUserEvent(roomShortCode, userName)
+		if err != nil {
+			if errors.Is(err, service.ErrSlowConsumer) {
+				logger.Info(fmt.Sprintf("[ID: %s]: Disconnected user [%s] from chat room with short code [%s] for not keeping up with messages", id, userName, roomShortCode))
 
-				return
+				return status.Error(codes.ResourceExhausted, errMsgSlowConsumer)
 			}
+			if errors.Is(err, service.ErrUserKicked) {
+				logger.Info(fmt.Sprintf("[ID: %s]: Disconnected user [%s] kicked from chat room with short code [%s]", id, userName, roomShortCode))
 
-			if err := chs.Send(&proto.ServerMessage{
-				UserName: msg.Sender,
-				Body:     msg.Body,
-			}); err != nil {
-				logger.Error(fmt.Sprintf("[ID: %s]: Failed to send message to user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
-
-				sendStopCh <- struct{}{}
+				return status.Error(codes.PermissionDenied, errMsgKicked)
+			}
+			if errors.Is(err, service.ErrUserBanned) {
+				logger.Info(fmt.Sprintf("[ID: %s]: Disconnected user [%s] banned from chat room with short code [%s]", id, userName, roomShortCode))
 
-				return
+				return status.Error(codes.PermissionDenied, errMsgBanned)
 			}
 
-			logger.Info(fmt.Sprintf("[ID: %s]: Sent message [{Sender: %s, Body: %s}] to user [%s] in chat room with short code [%s]", id, msg.Sender, msg.Body, userName, roomShortCode))
+			return nil
+		}
+
+		if err := chs.Send(newServerMessage(event)); err != nil {
+			logger.Error(fmt.Sprintf("[ID: %s]: Failed to send message to user [%s] in chat room with short code [%s]: %s", id, userName, roomShortCode, status.Convert(err).Message()))
+
+			_ = s.roomService.RemoveUserFromRoom(roomShortCode, userName)
+
+			return nil
+		}
+
+		switch event.Type {
+		case service.EventTypeMessage:
+			msg := event.Message
+			logger.Info(fmt.Sprintf("[ID: %s]: Sent message [{ID: %s, Sequence: %d, Sender: %s, Body: %s}] to user [%s] in chat room with short code [%s]", id, msg.ID, msg.Sequence, msg.Sender, msg.Body, userName, roomShortCode))
+		case service.EventTypeMessageEdited:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about edit of message with ID [%s]", id, userName, roomShortCode, event.Message.ID))
+		case service.EventTypeReactionChanged:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about reaction [%s] of user [%s] to message with ID [%s]", id, userName, roomShortCode, event.Reaction.Emoji, event.Reaction.UserName, event.Reaction.MessageID))
+		case service.EventTypeReadReceipt:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] reading messages up to sequence [%d]", id, userName, roomShortCode, event.UserName, event.ReadSequence))
+		case service.EventTypeMessageDeleted:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about deletion of message with ID [%s]", id, userName, roomShortCode, event.Message.ID))
+		case service.EventTypeUserJoined:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] joining", id, userName, roomShortCode, event.UserName))
+		case service.EventTypeUserLeft:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] leaving", id, userName, roomShortCode, event.UserName))
+		case service.EventTypeUserKicked:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] being kicked", id, userName, roomShortCode, event.UserName))
+		case service.EventTypeUserBanned:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] being banned", id, userName, roomShortCode, event.UserName))
+		case service.EventTypeRoleChanged:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] getting role [%s]", id, userName, roomShortCode, event.UserName, event.Role))
+		case service.EventTypeOwnerChanged:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about user [%s] becoming its owner", id, userName, roomShortCode, event.UserName))
+		case service.EventTypeRoomUpdated:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] about update of chat room with short code [%s]", id, userName, roomShortCode))
+		case service.EventTypeRoomDeleted:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] about deletion of chat room with short code [%s]", id, userName, roomShortCode))
+		case service.EventTypeMissedMessages:
+			logger.Info(fmt.Sprintf("[ID: %s]: Notified user [%s] in chat room with short code [%s] about [%d] dropped messages", id, userName, roomShortCode, event.MissedMessages))
+		case service.EventTypeAnnouncement:
+			logger.Info(fmt.Sprintf("[ID: %s]: Sent announcement with ID [%s] by user [%s] to user [%s] in chat room with short code [%s]", id, event.Message.ID, event.Message.Sender, userName, roomShortCode))
+		}
+	}
+}
+
+// newServerMessage converts the event from the user's message queue into a message sent on the chat stream.
+func newServerMessage(event *service.Event) *proto.ServerMessage {
+	serverMessage := &proto.ServerMessage{
+		Timestamp: timestamppb.New(event.Timestamp),
+	}
+
+	switch event.Type {
+	case service.EventTypeMessage:
+		chatMessage := &proto.ChatMessage{
+			Id:       event.Message.ID,
+			UserName: event.Message.Sender,
+			Body:     event.Message.Body,
+			Sequence: event.Message.Sequence,
+			Private:  event.Message.Recipient != "",
+			ReplyTo:  event.Message.ReplyTo,
 		}
+		if !event.Message.EditedAt.IsZero() {
+			chatMessage.EditedAt = timestamppb.New(event.Message.EditedAt)
+		}
+		serverMessage.Event = &proto.ServerMessage_Message{Message: chatMessage}
+	case service.EventTypeMessageEdited:
+		serverMessage.Event = &proto.ServerMessage_MessageEdited{MessageEdited: &proto.MessageEdited{Id: event.Message.ID, Body: event.Message.Body}}
+	case service.EventTypeMessageDeleted:
+		serverMessage.Event = &proto.ServerMessage_MessageDeleted{MessageDeleted: &proto.MessageDeleted{Id: event.Message.ID}}
+	case service.EventTypeReactionChanged:
+		serverMessage.Event = &proto.ServerMessage_ReactionChanged{ReactionChanged: &proto.ReactionChanged{
+			MessageId: event.Reaction.MessageID,
+			Emoji:     event.Reaction.Emoji,
+			UserName:  event.Reaction.UserName,
+			Added:     event.Reaction.Added,
+			Count:     int32(event.Reaction.Count),
+		}}
+	case service.EventTypeReadReceipt:
+		serverMessage.Event = &proto.ServerMessage_ReadReceipt{ReadReceipt: &proto.ReadReceipt{UserName: event.UserName, Sequence: event.ReadSequence}}
+	case service.EventTypeUserJoined:
+		serverMessage.Event = &proto.ServerMessage_UserJoined{UserJoined: &proto.UserJoined{UserName: event.UserName}}
+	case service.EventTypeUserLeft:
+		serverMessage.Event = &proto.ServerMessage_UserLeft{UserLeft: &proto.UserLeft{UserName: event.UserName}}
+	case service.EventTypeUserKicked:
+		serverMessage.Event = &proto.ServerMessage_UserKicked{UserKicked: &proto.UserKicked{UserName: event.UserName}}
+	case service.EventTypeUserBanned:
+		serverMessage.Event = &proto.ServerMessage_UserBanned{UserBanned: &proto.UserBanned{UserName: event.UserName}}
+	case service.EventTypeRoleChanged:
+		serverMessage.Event = &proto.ServerMessage_RoleChanged{RoleChanged: &proto.RoleChanged{UserName: event.UserName, Role: proto.RoomRole(event.Role)}}
+	case service.EventTypeOwnerChanged:
+		serverMessage.Event = &proto.ServerMessage_OwnerChanged{OwnerChanged: &proto.OwnerChanged{UserName: event.UserName}}
+	case service.EventTypeRoomUpdated:
+		serverMessage.Event = &proto.ServerMessage_RoomUpdated{RoomUpdated: &proto.RoomUpdated{Name: event.Room.Name, Description: event.Room.Description}}
+	case service.EventTypeRoomDeleted:
+		serverMessage.Event = &proto.ServerMessage_RoomDeleted{RoomDeleted: &proto.RoomDeleted{}}
+	case service.EventTypeMissedMessages:
+		serverMessage.Event = &proto.ServerMessage_MissedMessages{MissedMessages: &proto.MissedMessages{Count: event.MissedMessages}}
+	case service.EventTypeTyping:
+		serverMessage.Event = &proto.ServerMessage_UserTyping{UserTyping: &proto.UserTyping{UserName: event.UserName, Active: event.Typing}}
+	case service.EventTypeAnnouncement:
+		serverMessage.Event = &proto.ServerMessage_Announcement{Announcement: &proto.Announcement{UserName: event.Message.Sender, Body: event.Message.Body}}
 	}
+
+	return serverMessage
+}
+
+// newChatError converts the error of handling a message from the user into an error event sent to the user, if it is caused by the message itself.
+// The messageID is the ID of the message the user replied or reacted to.
+func newChatError(err error, recipient, messageID string) *proto.ChatError {
+	switch {
+	case errors.Is(err, service.ErrRecipientNotFound):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_RECIPIENT_NOT_FOUND,
+			Message: fmt.Sprintf(errMsgRecipientNotFound, recipient),
+		}
+	case errors.Is(err, service.ErrInvalidRecipient):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_RECIPIENT,
+			Message: errMsgInvalidRecipient,
+		}
+	case errors.Is(err, service.ErrMessageNotFound):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_MESSAGE_NOT_FOUND,
+			Message: fmt.Sprintf(errMsgMessageNotFound, messageID),
+		}
+	case errors.Is(err, service.ErrInvalidReaction):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_REACTION,
+			Message: fmt.Sprintf(errMsgInvalidReaction, service.MaxReactionLength),
+		}
+	case errors.Is(err, service.ErrInvalidReadSequence):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_READ_SEQUENCE,
+			Message: errMsgInvalidReadSequence,
+		}
+	case errors.Is(err, service.ErrMessageTooLarge):
+		return &proto.ChatError{
+			Code:    proto.ChatErrorCode_CHAT_ERROR_CODE_MESSAGE_TOO_LARGE,
+			Message: fmt.Sprintf(errMsgMessageTooLarge, service.MaxMessageBodySize),
+		}
+	default:
+		return nil
+	}
+}
+
+// lockedChatServer serializes sending messages on the chat stream.
+type lockedChatServer struct {
+	proto.GRPCChatter_ChatServer
+	mu sync.Mutex
+}
+
+func (lcs *lockedChatServer) Send(msg *proto.ServerMessage) error {
+	lcs.mu.Lock()
+	defer lcs.mu.Unlock()
+
+	return lcs.GRPCChatter_ChatServer.Send(msg)
 }
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/broker"
+	"github.com/MSSkowron/GRPCChatter/internal/repository"
+	"github.com/MSSkowron/GRPCChatter/internal/service"
+	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testChatServer is a chat stream, which receives the given messages and then blocks until its context is done.
+type testChatServer struct {
+	proto.GRPCChatter_ChatServer
+	ctx      context.Context
+	received chan *proto.ClientMessage
+}
+
+func (tcs *testChatServer) Context() context.Context {
+	return tcs.ctx
+}
+
+func (tcs *testChatServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (tcs *testChatServer) Send(*proto.ServerMessage) error {
+	return nil
+}
+
+func (tcs *testChatServer) Recv() (*proto.ClientMessage, error) {
+	select {
+	case msg := <-tcs.received:
+		return msg, nil
+	case <-tcs.ctx.Done():
+		return nil, status.FromContextError(tcs.ctx.Err()).Err()
+	}
+}
+
+func TestChatReadOnlySender(t *testing.T) {
+	roomService, err := service.NewRoomService(10, 10, service.SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+	require.NoError(t, err)
+	require.NoError(t, roomService.CreateRoom("ABC123", "Test Room", "password", "owner1", service.RoomSettings{}))
+	require.NoError(t, roomService.AddUserToRoom("ABC123", "user1"))
+	require.NoError(t, roomService.SetUserRole("ABC123", "owner1", "user1", service.RoomRoleReadOnly))
+	require.Eventually(t, func() bool {
+		role, err := roomService.GetUserRole("ABC123", "user1")
+		return err == nil && role == service.RoomRoleReadOnly
+	}, time.Second, 10*time.Millisecond)
+
+	s := NewServer(nil, nil, nil, roomService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = context.WithValue(ctx, contextKeyRPCID, "1")
+	ctx = context.WithValue(ctx, contextKeyShortCode, "ABC123")
+	ctx = context.WithValue(ctx, contextKeyUserName, "user1")
+
+	chs := &testChatServer{ctx: ctx, received: make(chan *proto.ClientMessage, 1)}
+	chs.received <- &proto.ClientMessage{Body: "muted"}
+
+	// Sending a message as a read-only user ends the stream.
+	err = s.Chat(chs)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, errMsgReadOnly, status.Convert(err).Message())
+
+	// The message has not been stored.
+	history, err := roomService.GetChatHistory("ABC123", "owner1", 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// redactedFields are the fields of request bodies whose values are not logged.
+var redactedFields = []string{"password", "refresh_token"}
+
 func (s *Server) logMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestID := uuid.New().String()
@@ -60,11 +63,23 @@ func getRequestBody(r *http.Request) (string, error) {
 
 	r.Body = io.NopCloser(bytes.NewBuffer(requestBodyBytes))
 
+	if len(requestBodyBytes) == 0 {
+		return "", nil
+	}
+
 	var requestBody any
 	if err := json.Unmarshal(requestBodyBytes, &requestBody); err != nil {
 		return "", err
 	}
 
+	if fields, ok := requestBody.(map[string]any); ok {
+		for _, field := range redactedFields {
+			if _, ok := fields[field]; ok {
+				fields[field] = "[REDACTED]"
+			}
+		}
+	}
+
 	requestBodyJSON, err := json.MarshalIndent(requestBody, "", "  ")
 	if err != nil {
 		return "", err
@@ -0,0 +1,130 @@
+package rest
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/dto"
+	"github.com/MSSkowron/GRPCChatter/pkg/crypto"
+	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/MSSkowron/GRPCChatter/pkg/oidc"
+)
+
+const (
+	// oidcCookieName is the name of the cookie carrying the state, nonce and code verifier of a pending OIDC login.
+	oidcCookieName = "oidc_login"
+	// oidcCookiePath limits the cookie to the OIDC endpoints.
+	oidcCookiePath = "/auth/oidc"
+	// oidcLoginTimeout is how long the user has to authenticate with the identity provider.
+	oidcLoginTimeout = 10 * time.Minute
+	// oidcValueSize is the number of random bytes the state, nonce and code verifier are generated from.
+	oidcValueSize = 32
+)
+
+// WithOIDCClient is an option to enable signing in with the OpenID Provider of the client, through the /auth/oidc/login and /auth/oidc/callback endpoints.
+func WithOIDCClient(oidcClient *oidc.Client) ServerOption {
+	return func(s *Server) {
+		s.oidcClient = oidcClient
+	}
+}
+
+func (s *Server) handleOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	values := make([]string, 3)
+	for i := range values {
+		value, err := crypto.GenerateToken(oidcValueSize)
+		if err != nil {
+			s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+			return
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	// The values are kept by the browser of the user, so that the callback can only complete the login the browser has started.
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Value:    strings.Join(values, "."),
+		Path:     oidcCookiePath,
+		MaxAge:   int(oidcLoginTimeout.Seconds()),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(s.oidcClient.RedirectURL(), "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, s.oidcClient.AuthCodeURL(state, nonce, codeVerifier), http.StatusFound)
+}
+
+func (s *Server) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
+	reqID, _ := r.Context().Value(contextKeyReqID).(string)
+
+	cookie, err := r.Cookie(oidcCookieName)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, ErrMsgBadRequestInvalidOIDCState)
+		return
+	}
+
+	// The login can be completed only once.
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Path:     oidcCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	values := strings.Split(cookie.Value, ".")
+	query := r.URL.Query()
+	if len(values) != 3 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(query.Get("state"))) != 1 {
+		s.respondWithError(w, http.StatusBadRequest, ErrMsgBadRequestInvalidOIDCState)
+		return
+	}
+	nonce, codeVerifier := values[1], values[2]
+
+	if errCode := query.Get("error"); errCode != "" {
+		logger.Info(fmt.Sprintf("[ID: %s]: OIDC login refused by identity provider: %s %s", reqID, errCode, query.Get("error_description")))
+		s.respondWithError(w, http.StatusUnauthorized, fmt.Sprintf("%s:%s", ErrMsgUnauthorized, errCode))
+		return
+	}
+
+	idToken, err := s.oidcClient.Exchange(r.Context(), query.Get("code"), codeVerifier, nonce)
+	if err != nil {
+		logger.Error(fmt.Sprintf("[ID: %s]: Failed to complete OIDC login: %s", reqID, err))
+
+		switch {
+		case errors.Is(err, oidc.ErrExchangeFailed), errors.Is(err, oidc.ErrInvalidIDToken):
+			s.respondWithError(w, http.StatusUnauthorized, ErrMsgUnauthorized)
+		default:
+			s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+		}
+		return
+	}
+
+	tokenDTO, err := s.userService.LoginExternalUser(r.Context(), &dto.ExternalUserDTO{
+		Issuer:   idToken.Issuer,
+		Subject:  idToken.Subject,
+		Username: externalUsername(idToken),
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("[ID: %s]: Failed to sign in external user [%s]: %s", reqID, idToken.Subject, err))
+		s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+		return
+	}
+
+	s.respondWithJSON(w, http.StatusOK, tokenDTO)
+}
+
+// externalUsername returns the user name preferred by the user authenticated with the ID token, falling back to the local part of their email, their name and their subject.
+func externalUsername(idToken *oidc.IDToken) string {
+	email, _, _ := strings.Cut(idToken.Email, "@")
+
+	for _, username := range []string{idToken.PreferredUsername, email, idToken.Name} {
+		if username != "" {
+			return username
+		}
+	}
+
+	return idToken.Subject
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/MSSkowron/GRPCChatter/internal/dto"
 	"github.com/MSSkowron/GRPCChatter/internal/service"
 	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/MSSkowron/GRPCChatter/pkg/oidc"
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
 	"github.com/MSSkowron/GRPCChatter/pkg/validation"
 	"github.com/gorilla/mux"
 )
@@ -26,6 +28,9 @@ const (
 	// DefaultReadTimeout is the default read timeout for incoming requests.
 	DefaultReadTimeout = 15 * time.Second
 
+	// jwksMaxAge is how long clients may cache the response of the JWKS endpoint.
+	jwksMaxAge = 5 * time.Minute
+
 	contextKeyReqID = contextKey("reqID")
 
 	// ErrMsgUnauthorized is a http response body message for unauthorized status code.
@@ -34,12 +39,16 @@ const (
 	ErrMsgBadRequestInvalidRequestBody = "Invalid request body"
 	// ErrMsgInternalServerError is a http response body message for internal server error status code.
 	ErrMsgInternalServerError = "Internal server error"
+	// ErrMsgBadRequestInvalidOIDCState is a http response body message for bad request status code, when the OIDC callback does not match a pending login.
+	ErrMsgBadRequestInvalidOIDCState = "Invalid or expired OIDC login state"
 )
 
 // Server represents a gRPC server.
 type Server struct {
 	*http.Server
 	userService service.UserService
+	keySets     []*token.KeySet
+	oidcClient  *oidc.Client
 }
 
 // NewServer creates a new Server instance.
@@ -72,6 +81,13 @@ func WithAddress(addr string) ServerOption {
 	}
 }
 
+// WithKeySets is an option to set the key sets whose public keys are published by the JWKS endpoint.
+func WithKeySets(keySets ...*token.KeySet) ServerOption {
+	return func(s *Server) {
+		s.keySets = keySets
+	}
+}
+
 // WithReadTimeout is an option to set the read timeout for the server.
 func WithReadTimeout(timeout time.Duration) ServerOption {
 	return func(s *Server) {
@@ -93,6 +109,13 @@ func (s *Server) initRoutes() {
 
 	r.HandleFunc("/register", s.handleRegister).Methods("POST")
 	r.HandleFunc("/login", s.handleLogin).Methods("POST")
+	r.HandleFunc("/refresh", s.handleRefresh).Methods("POST")
+	r.HandleFunc("/logout", s.handleLogout).Methods("POST")
+	r.HandleFunc("/.well-known/jwks.json", s.handleJWKS).Methods("GET")
+	if s.oidcClient != nil {
+		r.HandleFunc("/auth/oidc/login", s.handleOIDCLogin).Methods("GET")
+		r.HandleFunc("/auth/oidc/callback", s.handleOIDCCallback).Methods("GET")
+	}
 
 	s.Handler = r
 }
@@ -143,6 +166,60 @@ func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
 	s.respondWithJSON(w, http.StatusOK, tokenDTO)
 }
 
+func (s *Server) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	refreshTokenDTO := &dto.RefreshTokenDTO{}
+	if err := json.NewDecoder(r.Body).Decode(refreshTokenDTO); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, ErrMsgBadRequestInvalidRequestBody)
+		return
+	}
+
+	tokenDTO, err := s.userService.RefreshToken(r.Context(), refreshTokenDTO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidRefreshToken):
+			s.respondWithError(w, http.StatusUnauthorized, fmt.Sprintf("%s:%s", ErrMsgUnauthorized, err))
+		default:
+			s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+		}
+		return
+	}
+
+	s.respondWithJSON(w, http.StatusOK, tokenDTO)
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	refreshTokenDTO := &dto.RefreshTokenDTO{}
+	if err := json.NewDecoder(r.Body).Decode(refreshTokenDTO); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, ErrMsgBadRequestInvalidRequestBody)
+		return
+	}
+
+	if err := s.userService.LogoutUser(r.Context(), refreshTokenDTO); err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidRefreshToken):
+			s.respondWithError(w, http.StatusUnauthorized, fmt.Sprintf("%s:%s", ErrMsgUnauthorized, err))
+		default:
+			s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	// Without key sets, or with shared secrets only, there are no public keys to publish.
+	jwks, err := token.NewJWKS(s.keySets...)
+	if err != nil {
+		s.respondWithError(w, http.StatusInternalServerError, ErrMsgInternalServerError)
+		return
+	}
+
+	// Verifiers may cache the keys, but should fetch them again soon enough to pick up a rotated one.
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(jwksMaxAge.Seconds())))
+	s.respondWithJSON(w, http.StatusOK, jwks)
+}
+
 func (s *Server) respondWithError(w http.ResponseWriter, errCode int, errMessage string) {
 	s.respondWithJSON(w, errCode, dto.ErrorDTO{Error: errMessage})
 }
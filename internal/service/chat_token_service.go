@@ -1,22 +1,30 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
+	"github.com/MSSkowron/GRPCChatter/internal/revocation"
+	signing "github.com/MSSkowron/GRPCChatter/pkg/token"
 	token "github.com/MSSkowron/GRPCChatter/pkg/token/chattoken"
 )
 
+// defaultChatTokenDuration is the default duration for which a chat token is valid.
+const defaultChatTokenDuration = time.Hour
+
 // ErrInvalidChatToken is returned when the token is invalid.
 var ErrInvalidChatToken = errors.New("invalid token")
 
 // ChatTokenService is an interface that defines the methods required for token management.
 type ChatTokenService interface {
-	// GenerateToken generates a token for a given username and short code.
+	// GenerateToken generates a token for a given username, short code and the user's role in the chat room.
 	// It returns the generated token and an error if the generation fails.
-	GenerateToken(username, shortCode string) (string, error)
+	GenerateToken(username, shortCode string, role RoomRole) (string, error)
 
-	// ValidateToken validates a token and returns an error if it's invalid.
+	// ValidateToken validates a token and returns an error if it's invalid or expired.
 	ValidateToken(token string) error
 
 	// GetUserNameFromToken retrieves the username from a token.
@@ -26,22 +34,47 @@ type ChatTokenService interface {
 	// GetShortCodeFromToken retrieves the short code from a token.
 	// It returns the short code and an error if the retrieval fails.
 	GetShortCodeFromToken(token string) (string, error)
+
+	// GetRoleFromToken retrieves the user's role in the chat room from a token.
+	// The role is the one the user had when the token was generated, so it is informational only and must not be used for authorization.
+	// It returns the role and an error if the retrieval fails.
+	GetRoleFromToken(token string) (RoomRole, error)
+
+	// GetExpirationFromToken retrieves the time a token expires at.
+	// It returns the expiration time and an error if the retrieval fails.
+	GetExpirationFromToken(token string) (time.Time, error)
+
+	// RevokeTokens revokes all tokens generated so far for a given username and short code.
+	// If the username is empty, the tokens of all users of the chat room are revoked.
+	RevokeTokens(username, shortCode string) error
+
+	// IsTokenRevoked checks if a token has been revoked.
+	IsTokenRevoked(token string) (bool, error)
 }
 
 // ChatTokenServiceImpl implements the ChatTokenService interface.
 type ChatTokenServiceImpl struct {
-	secret string
+	issuer          *signing.Issuer
+	duration        time.Duration
+	revocationStore revocation.Store
 }
 
-// NewChatTokenService creates a new ChatTokenServiceImpl instance with the provided secret.
-func NewChatTokenService(secret string) *ChatTokenServiceImpl {
+// NewChatTokenService creates a new ChatTokenServiceImpl instance with the provided issuer, duration and revocationStore.
+// If the duration is not positive, tokens are valid for an hour.
+func NewChatTokenService(issuer *signing.Issuer, duration time.Duration, revocationStore revocation.Store) *ChatTokenServiceImpl {
+	if duration <= 0 {
+		duration = defaultChatTokenDuration
+	}
+
 	return &ChatTokenServiceImpl{
-		secret: secret,
+		issuer:          issuer,
+		duration:        duration,
+		revocationStore: revocationStore,
 	}
 }
 
-func (s *ChatTokenServiceImpl) GenerateToken(username, shortCode string) (string, error) {
-	token, err := token.Generate(username, shortCode, s.secret)
+func (s *ChatTokenServiceImpl) GenerateToken(username, shortCode string, role RoomRole) (string, error) {
+	token, err := token.Generate(username, shortCode, role.String(), s.duration, s.issuer)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -49,14 +82,14 @@ func (s *ChatTokenServiceImpl) GenerateToken(username, shortCode string) (string
 }
 
 func (s *ChatTokenServiceImpl) ValidateToken(t string) error {
-	if err := token.Validate(t, s.secret); err != nil {
+	if err := token.Validate(t, s.issuer); err != nil {
 		return ErrInvalidChatToken
 	}
 	return nil
 }
 
 func (s *ChatTokenServiceImpl) GetUserNameFromToken(t string) (string, error) {
-	userName, err := token.GetClaim(t, s.secret, token.ClaimUserNameKey)
+	userName, err := token.GetClaim[string](t, s.issuer, token.ClaimUserNameKey)
 	if err != nil {
 		return "", ErrInvalidChatToken
 	}
@@ -64,9 +97,66 @@ func (s *ChatTokenServiceImpl) GetUserNameFromToken(t string) (string, error) {
 }
 
 func (s *ChatTokenServiceImpl) GetShortCodeFromToken(t string) (string, error) {
-	shortCode, err := token.GetClaim(t, s.secret, token.ClaimShortCodeKey)
+	shortCode, err := token.GetClaim[string](t, s.issuer, token.ClaimShortCodeKey)
 	if err != nil {
 		return "", ErrInvalidChatToken
 	}
 	return shortCode, nil
 }
+
+func (s *ChatTokenServiceImpl) GetRoleFromToken(t string) (RoomRole, error) {
+	roleName, err := token.GetClaim[string](t, s.issuer, token.ClaimRoleKey)
+	if err != nil {
+		return 0, ErrInvalidChatToken
+	}
+
+	role, err := ParseRoomRole(roleName)
+	if err != nil {
+		return 0, ErrInvalidChatToken
+	}
+	return role, nil
+}
+
+func (s *ChatTokenServiceImpl) GetExpirationFromToken(t string) (time.Time, error) {
+	expiresAt, err := token.GetClaim[float64](t, s.issuer, token.ClaimExpiresAtKey)
+	if err != nil {
+		return time.Time{}, ErrInvalidChatToken
+	}
+	return time.Unix(int64(expiresAt), 0), nil
+}
+
+func (s *ChatTokenServiceImpl) RevokeTokens(username, shortCode string) error {
+	// Tokens carry their issue time with microsecond precision, so tokens generated right after the revocation stay valid.
+	// Revoked tokens are useless once they have expired, so the revocation can be forgotten afterwards.
+	revokedAt := time.Now().Truncate(time.Microsecond)
+	if err := s.revocationStore.Revoke(context.Background(), shortCode, username, revokedAt, revokedAt.Add(s.duration)); err != nil {
+		return fmt.Errorf("failed to revoke tokens: %w", err)
+	}
+	return nil
+}
+
+func (s *ChatTokenServiceImpl) IsTokenRevoked(t string) (bool, error) {
+	userName, err := s.GetUserNameFromToken(t)
+	if err != nil {
+		return false, err
+	}
+
+	shortCode, err := s.GetShortCodeFromToken(t)
+	if err != nil {
+		return false, err
+	}
+
+	issuedAt, err := token.GetClaim[float64](t, s.issuer, token.ClaimIssuedAtKey)
+	if err != nil {
+		return false, ErrInvalidChatToken
+	}
+
+	// The issue time is in seconds with microsecond precision, which rounding recovers exactly.
+	issuedAtMicro := int64(math.Round(issuedAt * float64(time.Second/time.Microsecond)))
+
+	revoked, err := s.revocationStore.IsRevoked(context.Background(), shortCode, userName, time.UnixMicro(issuedAtMicro))
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	return revoked, nil
+}
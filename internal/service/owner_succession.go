@@ -0,0 +1,45 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OwnerSuccessionPolicy decides what happens to a chat room when its owner leaves it.
+type OwnerSuccessionPolicy int
+
+const (
+	// OwnerSuccessionPolicyNone keeps the room with its owner.
+	OwnerSuccessionPolicyNone OwnerSuccessionPolicy = iota
+	// OwnerSuccessionPolicyPromote makes the user present in the room for the longest time its new owner.
+	OwnerSuccessionPolicyPromote
+	// OwnerSuccessionPolicyDelete deletes the room after a grace period, unless the owner joins it again in the meantime.
+	OwnerSuccessionPolicyDelete
+)
+
+// ErrInvalidOwnerSuccessionPolicy is returned when an unknown owner succession policy is provided.
+var ErrInvalidOwnerSuccessionPolicy = errors.New("invalid owner succession policy")
+
+var ownerSuccessionPolicyNames = map[OwnerSuccessionPolicy]string{
+	OwnerSuccessionPolicyNone:    "NONE",
+	OwnerSuccessionPolicyPromote: "PROMOTE",
+	OwnerSuccessionPolicyDelete:  "DELETE",
+}
+
+// String returns the name of the owner succession policy.
+func (p OwnerSuccessionPolicy) String() string {
+	if name, ok := ownerSuccessionPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("OwnerSuccessionPolicy(%d)", int(p))
+}
+
+// ParseOwnerSuccessionPolicy parses an owner succession policy from its name, e.g. PROMOTE.
+func ParseOwnerSuccessionPolicy(name string) (OwnerSuccessionPolicy, error) {
+	for policy, policyName := range ownerSuccessionPolicyNames {
+		if policyName == name {
+			return policy, nil
+		}
+	}
+	return OwnerSuccessionPolicyNone, fmt.Errorf("%w: %s", ErrInvalidOwnerSuccessionPolicy, name)
+}
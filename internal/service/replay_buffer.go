@@ -0,0 +1,72 @@
+package service
+
+// replayBuffer is a bounded ring buffer holding the most recent messages broadcast in a chat room.
+// It is not safe for concurrent use.
+type replayBuffer struct {
+	messages []*Message
+	start    int
+	size     int
+}
+
+func newReplayBuffer(capacity int) *replayBuffer {
+	return &replayBuffer{
+		messages: make([]*Message, capacity),
+	}
+}
+
+// add appends the message to the buffer, overwriting the oldest message if the buffer is full.
+func (rb *replayBuffer) add(message *Message) {
+	if len(rb.messages) == 0 {
+		return
+	}
+
+	if rb.size < len(rb.messages) {
+		rb.messages[(rb.start+rb.size)%len(rb.messages)] = message
+		rb.size++
+		return
+	}
+
+	rb.messages[rb.start] = message
+	rb.start = (rb.start + 1) % len(rb.messages)
+}
+
+// since returns the buffered messages with a sequence number greater than the given one, oldest first.
+func (rb *replayBuffer) since(sequence uint64) []*Message {
+	messages := []*Message{}
+	for i := 0; i < rb.size; i++ {
+		message := rb.messages[(rb.start+i)%len(rb.messages)]
+		if message.Sequence > sequence {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+// replace replaces the buffered message with the same ID as the given one, if any.
+func (rb *replayBuffer) replace(message *Message) {
+	for i := 0; i < rb.size; i++ {
+		j := (rb.start + i) % len(rb.messages)
+		if rb.messages[j].ID == message.ID {
+			rb.messages[j] = message
+			return
+		}
+	}
+}
+
+// remove removes the buffered message with the given ID, if any, keeping the order of the other messages.
+func (rb *replayBuffer) remove(id string) {
+	kept := 0
+	for i := 0; i < rb.size; i++ {
+		message := rb.messages[(rb.start+i)%len(rb.messages)]
+		if message.ID != id {
+			rb.messages[(rb.start+kept)%len(rb.messages)] = message
+			kept++
+		}
+	}
+
+	for i := kept; i < rb.size; i++ {
+		rb.messages[(rb.start+i)%len(rb.messages)] = nil
+	}
+	rb.size = kept
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayBufferSince(t *testing.T) {
+	rb := newReplayBuffer(3)
+	require.Empty(t, rb.since(0))
+
+	for i := uint64(1); i <= 2; i++ {
+		rb.add(&Message{Sequence: i})
+	}
+	require.Equal(t, []uint64{1, 2}, sequences(rb.since(0)))
+	require.Equal(t, []uint64{2}, sequences(rb.since(1)))
+
+	// Oldest messages are overwritten once the buffer is full
+	for i := uint64(3); i <= 5; i++ {
+		rb.add(&Message{Sequence: i})
+	}
+	require.Equal(t, []uint64{3, 4, 5}, sequences(rb.since(0)))
+	require.Equal(t, []uint64{5}, sequences(rb.since(4)))
+	require.Empty(t, rb.since(5))
+}
+
+func TestReplayBufferZeroCapacity(t *testing.T) {
+	rb := newReplayBuffer(0)
+	rb.add(&Message{Sequence: 1})
+	require.Empty(t, rb.since(0))
+}
+
+func TestReplayBufferReplaceAndRemove(t *testing.T) {
+	rb := newReplayBuffer(3)
+	for i := uint64(1); i <= 4; i++ {
+		rb.add(&Message{ID: fmt.Sprint(i), Sequence: i, Body: "hello"})
+	}
+
+	rb.replace(&Message{ID: "3", Sequence: 3, Body: "edited"})
+	rb.replace(&Message{ID: "1", Sequence: 1, Body: "edited"})
+	require.Equal(t, []uint64{2, 3, 4}, sequences(rb.since(0)))
+	require.Equal(t, "edited", rb.since(2)[0].Body)
+
+	rb.remove("3")
+	rb.remove("1")
+	require.Equal(t, []uint64{2, 4}, sequences(rb.since(0)))
+
+	// Removed messages make room for new ones
+	rb.add(&Message{ID: "5", Sequence: 5})
+	rb.add(&Message{ID: "6", Sequence: 6})
+	require.Equal(t, []uint64{4, 5, 6}, sequences(rb.since(0)))
+}
+
+func sequences(messages []*Message) []uint64 {
+	seqs := make([]uint64, 0, len(messages))
+	for _, message := range messages {
+		seqs = append(seqs, message.Sequence)
+	}
+	return seqs
+}
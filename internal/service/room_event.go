@@ -0,0 +1,102 @@
+package service
+
+import "time"
+
+// EventType is the type of an event received by a user in a chat room.
+type EventType int
+
+const (
+	// EventTypeMessage means a message has been sent in the chat room.
+	EventTypeMessage EventType = iota
+	// EventTypeMessageEdited means a message sent in the chat room has been edited.
+	EventTypeMessageEdited
+	// EventTypeMessageDeleted means a message sent in the chat room has been deleted.
+	EventTypeMessageDeleted
+	// EventTypeReactionChanged means a user has reacted to a message sent in the chat room or withdrawn their reaction.
+	EventTypeReactionChanged
+	// EventTypeReadReceipt means a user has read the messages of the chat room up to a sequence number.
+	EventTypeReadReceipt
+	// EventTypeUserJoined means a user has joined the chat room.
+	EventTypeUserJoined
+	// EventTypeUserLeft means a user has left the chat room.
+	EventTypeUserLeft
+	// EventTypeUserKicked means a user has been kicked from the chat room by its owner.
+	EventTypeUserKicked
+	// EventTypeUserBanned means a user has been banned from the chat room by its owner.
+	EventTypeUserBanned
+	// EventTypeRoleChanged means the role of a user in the chat room has been changed.
+	EventTypeRoleChanged
+	// EventTypeOwnerChanged means the ownership of the chat room has been transferred to a user.
+	EventTypeOwnerChanged
+	// EventTypeRoomUpdated means the name or description of the chat room has been changed.
+	EventTypeRoomUpdated
+	// EventTypeRoomDeleted means the chat room has been deleted. It is the last event received in the chat room.
+	EventTypeRoomDeleted
+	// EventTypeMissedMessages means events have been dropped because the user did not keep up with the chat room.
+	EventTypeMissedMessages
+	// EventTypeTyping means a user has started or stopped typing in the chat room.
+	EventTypeTyping
+	// EventTypeAnnouncement means a server-wide announcement has been made to users in all chat rooms.
+	EventTypeAnnouncement
+)
+
+// Event represents an event received by a user in a chat room.
+type Event struct {
+	// Type is the type of the event.
+	Type EventType
+
+	// Timestamp is the time the event occurred at.
+	Timestamp time.Time
+
+	// Message is the message sent, edited or deleted in the chat room, or the announcement. It is set only for EventTypeMessage, EventTypeMessageEdited, EventTypeMessageDeleted and EventTypeAnnouncement.
+	// Announcements have only their ID, timestamp, sender and body set.
+	Message *Message
+
+	// UserName is the name of the user who joined, left, has been kicked or banned, has got a new role, has become the owner, is typing or read messages in the chat room.
+	// It is set only for EventTypeUserJoined, EventTypeUserLeft, EventTypeUserKicked, EventTypeUserBanned, EventTypeRoleChanged, EventTypeOwnerChanged, EventTypeTyping and EventTypeReadReceipt.
+	UserName string
+
+	// Typing is true if the user has started typing and false if they have stopped. It is set only for EventTypeTyping.
+	Typing bool
+
+	// ReadSequence is the sequence number of the last message read by the user. It is set only for EventTypeReadReceipt.
+	ReadSequence uint64
+
+	// Reaction is the change of reactions to a message. It is set only for EventTypeReactionChanged.
+	Reaction *Reaction
+
+	// Role is the new role of the user. It is set only for EventTypeRoleChanged.
+	Role RoomRole
+
+	// Room is the information about the chat room after the update. It is set only for EventTypeRoomUpdated.
+	Room *RoomInfo
+
+	// MissedMessages is the number of events dropped because the user's queue was full. It is set only for EventTypeMissedMessages.
+	MissedMessages uint64
+}
+
+// Reaction represents a change of reactions to a message sent in a chat room.
+type Reaction struct {
+	// MessageID is the ID of the message reacted to.
+	MessageID string
+
+	// Emoji is the emoji the user reacted with.
+	Emoji string
+
+	// UserName is the name of the user who added or withdrew the reaction.
+	UserName string
+
+	// Added is true if the reaction has been added and false if it has been withdrawn.
+	Added bool
+
+	// Count is the number of users who reacted to the message with the emoji after the change.
+	Count int
+}
+
+func newMessageEvent(message *Message) *Event {
+	return &Event{
+		Type:      EventTypeMessage,
+		Timestamp: message.Timestamp,
+		Message:   message,
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RoomRole is the role of a user in a chat room, which decides what they can do in it.
+// Roles are ordered by their privileges, so a role grants everything granted by the lower ones.
+type RoomRole int
+
+const (
+	// RoomRoleReadOnly lets the user receive the messages of the room, but not send messages or reactions.
+	RoomRoleReadOnly RoomRole = iota + 1
+	// RoomRoleMember lets the user send messages and reactions. It is the role of users who join the room.
+	RoomRoleMember
+	// RoomRoleModerator additionally lets the user kick and mute members and delete messages of other users.
+	RoomRoleModerator
+	// RoomRoleOwner is the role of the user who created the room, who can do everything in it.
+	RoomRoleOwner
+)
+
+// ErrInvalidRoomRole is returned when an unknown room role is provided, or a role that cannot be given to users.
+var ErrInvalidRoomRole = errors.New("invalid room role")
+
+var roomRoleNames = map[RoomRole]string{
+	RoomRoleReadOnly:  "READ_ONLY",
+	RoomRoleMember:    "MEMBER",
+	RoomRoleModerator: "MODERATOR",
+	RoomRoleOwner:     "OWNER",
+}
+
+// String returns the name of the room role.
+func (r RoomRole) String() string {
+	if name, ok := roomRoleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("RoomRole(%d)", int(r))
+}
+
+// ParseRoomRole parses a room role from its name, e.g. MODERATOR.
+func ParseRoomRole(name string) (RoomRole, error) {
+	for role, roleName := range roomRoleNames {
+		if roleName == name {
+			return role, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrInvalidRoomRole, name)
+}
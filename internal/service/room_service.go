@@ -1,10 +1,34 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
+	"github.com/MSSkowron/GRPCChatter/internal/broker"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+	"github.com/MSSkowron/GRPCChatter/internal/repository"
 	"github.com/MSSkowron/GRPCChatter/pkg/crypto"
+	"github.com/MSSkowron/GRPCChatter/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	// typingTimeout is the time after which a user who has started typing is considered to have stopped.
+	typingTimeout = 5 * time.Second
+	// defaultReaperInterval is the default time between deletions of expired and idle rooms.
+	defaultReaperInterval = time.Minute
+	// MaxReactionLength is the maximum number of characters of a reaction.
+	MaxReactionLength = 16
+	// MaxMessageBodySize is the maximum size of a message body in bytes.
+	// It keeps the events carrying messages within the payload limit of the postgres broker, even if every byte of the body has to be escaped.
+	MaxMessageBodySize = 1000
 )
 
 var (
@@ -22,15 +46,137 @@ var (
 	ErrUserMessageQueueClosed = errors.New("user message queue is closed")
 	// ErrNotOwner is returned when a user is not the owner of the room and is trying to perform an operation that requires owner privileges.
 	ErrNotOwner = errors.New("user is not the owner of the rooom")
+	// ErrInvalidRoomName is returned when a room's name is empty.
+	ErrInvalidRoomName = errors.New("room name must not be empty")
+	// ErrRecipientNotFound is returned when the recipient of a private message is not in the chat room.
+	ErrRecipientNotFound = errors.New("recipient not found in the chat room")
+	// ErrInvalidRecipient is returned when a user sends a private message to themselves.
+	ErrInvalidRecipient = errors.New("cannot send a private message to yourself")
+	// ErrMessageNotFound is returned when a requested message is not found in the chat room.
+	ErrMessageNotFound = errors.New("message not found")
+	// ErrNotMessageSender is returned when a user is neither the sender of a message nor has a role in the room that permits modifying messages of other users.
+	ErrNotMessageSender = errors.New("user is neither the sender of the message nor permitted to modify messages of other users")
+	// ErrInsufficientRole is returned when a user's role in the room does not permit the operation.
+	ErrInsufficientRole = errors.New("user's role in the chat room does not permit the operation")
+	// ErrOwnerRole is returned when the role of the owner of the room is being changed.
+	ErrOwnerRole = errors.New("role of the owner of the chat room cannot be changed")
+	// ErrInvalidRoomTTL is returned when a room's time to live is negative.
+	ErrInvalidRoomTTL = errors.New("room time to live must not be negative")
+	// ErrAlreadyOwner is returned when the ownership of the room is being transferred to its owner.
+	ErrAlreadyOwner = errors.New("user is already the owner of the chat room")
+	// ErrInvalidReaction is returned when a reaction is empty or too long.
+	ErrInvalidReaction = fmt.Errorf("reaction must be between 1 and %d characters long", MaxReactionLength)
+	// ErrInvalidReadSequence is returned when a user marks as read messages with a sequence number that has not been assigned in the chat room yet.
+	ErrInvalidReadSequence = errors.New("sequence number has not been assigned in the chat room yet")
+	// ErrCannotRemoveOwner is returned when the owner of the room is being kicked or banned from it.
+	ErrCannotRemoveOwner = errors.New("owner cannot be kicked or banned from the chat room")
+	// ErrUserKicked is returned when a user has been kicked from the room and has not joined it again.
+	ErrUserKicked = errors.New("user has been kicked from the chat room")
+	// ErrUserBanned is returned when a user is banned from the room.
+	ErrUserBanned = errors.New("user is banned from the chat room")
+	// ErrUserNotBanned is returned when a user who is not banned from the room is being unbanned.
+	ErrUserNotBanned = errors.New("user is not banned from the chat room")
+	// ErrInvalidAnnouncement is returned when an announcement is empty.
+	ErrInvalidAnnouncement = errors.New("announcement must not be empty")
+	// ErrMessageTooLarge is returned when the message body exceeds MaxMessageBodySize.
+	ErrMessageTooLarge = fmt.Errorf("message body must not exceed %d bytes", MaxMessageBodySize)
+	// ErrSlowConsumer is returned when a user has been removed from the room because their message queue was full.
+	ErrSlowConsumer = errors.New("user has been disconnected for not keeping up with messages")
 )
 
 // Message represents a chat message with a sender and body.
 type Message struct {
+	// ID is the server-assigned unique identifier of the message.
+	ID string
+
+	// Sequence is the server-assigned number of the message, monotonically increasing within a chat room.
+	Sequence uint64
+
+	// Timestamp is the time the message was received by the server.
+	Timestamp time.Time
+
 	// Sender is the name of the user who sent the message.
 	Sender string
 
 	// Body is the content of the message.
 	Body string
+
+	// Recipient is the name of the only user the message is sent to.
+	// It is empty for messages sent to all users in the chat room.
+	Recipient string
+
+	// EditedAt is the time the message was last edited at. It is zero for messages that have not been edited.
+	EditedAt time.Time
+
+	// ReplyTo is the ID of the message this message replies to. It is empty for messages that are not replies.
+	ReplyTo string
+}
+
+// UnreadCount represents the number of messages a user has not read in a chat room.
+type UnreadCount struct {
+	// ShortCode is the short code of the chat room.
+	ShortCode string
+
+	// RoomName is the name of the chat room.
+	RoomName string
+
+	// LastReadSequence is the sequence number of the last message the user has read in the chat room.
+	LastReadSequence uint64
+
+	// Count is the number of messages visible to the user, sent by other users after the last read message.
+	Count int
+}
+
+// RoomSettings represents the optional settings of a chat room.
+type RoomSettings struct {
+	// SlowConsumerPolicy decides what happens when a message is broadcast to a user whose message queue is full.
+	SlowConsumerPolicy SlowConsumerPolicy
+
+	// Public makes the chat room discoverable by all users. Private chat rooms can be joined only by users who know their short code.
+	Public bool
+
+	// TTL is the time after the creation of the chat room at which it expires and is deleted. Zero means the chat room never expires.
+	// It is only used when creating the chat room, whose expiration time is given by RoomInfo.ExpiresAt.
+	TTL time.Duration
+}
+
+// RoomUpdate represents a change of a chat room. Only the non-nil fields are changed.
+type RoomUpdate struct {
+	// Name is the new name of the chat room.
+	Name *string
+
+	// Password is the new password of the chat room.
+	Password *string
+
+	// Description is the new description or topic of the chat room.
+	Description *string
+}
+
+// RoomInfo represents the information about a chat room shown to users.
+type RoomInfo struct {
+	// ShortCode is the short code of the chat room.
+	ShortCode string
+
+	// Name is the name of the chat room.
+	Name string
+
+	// Description is the description or topic of the chat room.
+	Description string
+
+	// Owner is the name of the user who created the chat room.
+	Owner string
+
+	// CreatedAt is the time the chat room was created at.
+	CreatedAt time.Time
+
+	// Settings are the settings of the chat room.
+	Settings RoomSettings
+
+	// MemberCount is the number of users currently in the chat room, connected through any application instance.
+	MemberCount int
+
+	// ExpiresAt is the time the chat room expires at. Zero time means the chat room never expires.
+	ExpiresAt time.Time
 }
 
 // RoomService is an interface that defines the methods required for users and rooms management.
@@ -41,57 +187,410 @@ type RoomService interface {
 	// CheckPassword checks if the provided password matches the room's password.
 	CheckPassword(shortCode, password string) error
 
-	// CreateRoom creates a new chat room with the given short code, name, and password.
-	CreateRoom(shortCode, name, password, owner string) error
+	// CreateRoom creates a new chat room with the given short code, name, password and settings.
+	// A chat room created with a time to live is deleted once it expires, like chat rooms no user has been present in for longer than the room service's idle timeout.
+	CreateRoom(shortCode, name, password, owner string, settings RoomSettings) error
+
+	// ListPublicRooms retrieves up to limit public chat rooms whose names contain nameFilter, ignoring case, ordered by short code.
+	// Only rooms with a short code greater than the cursor are returned. If the cursor is empty, the rooms are returned from the first one.
+	ListPublicRooms(nameFilter, cursor string, limit int) ([]*RoomInfo, error)
+
+	// ListRooms retrieves up to limit chat rooms, both public and private, whose names contain nameFilter, ignoring case, ordered by short code.
+	// Only rooms with a short code greater than the cursor are returned. If the cursor is empty, the rooms are returned from the first one.
+	ListRooms(nameFilter, cursor string, limit int) ([]*RoomInfo, error)
+
+	// GetRoomInfo retrieves the information about a chat room with the given short code.
+	GetRoomInfo(shortCode string) (*RoomInfo, error)
+
+	// UpdateRoom changes the name, password or description of a chat room with the given short code.
+	// Only the owner of the chat room can update it. Users in the chat room receive an EventTypeRoomUpdated event.
+	UpdateRoom(shortCode, userName string, update RoomUpdate) error
 
 	// DeleteRoom deletes a chat room with the given short code.
+	// Users in the chat room receive an EventTypeRoomDeleted event after all previously queued events, and their message queues are closed.
 	DeleteRoom(shortCode, userName string) error
 
+	// ForceDeleteRoom deletes a chat room with the given short code regardless of its owner, like DeleteRoom.
+	ForceDeleteRoom(shortCode string) error
+
+	// TransferOwnership makes the new owner, who must be a member of a chat room with the given short code, its owner in place of the user, who becomes a regular member.
+	// Only the owner of the chat room can transfer its ownership. Users in the chat room receive an EventTypeOwnerChanged event.
+	TransferOwnership(shortCode, userName, newOwner string) error
+
 	// AddUserToRoom adds a user to a chat room with the given short code and user name.
+	// Other users in the chat room receive an EventTypeUserJoined event, unless the user is already connected through another application instance.
+	// The first time a user joins a chat room, they become its member, with the messages sent before considered read.
 	AddUserToRoom(shortCode string, userName string) error
 
+	// ResumeUserInRoom adds a user back to a chat room with the given short code after their message stream has been interrupted.
+	// Messages with a sequence number greater than lastSequence that are still held in the room's replay buffer are queued for the user before any new message.
+	ResumeUserInRoom(shortCode string, userName string, lastSequence uint64) error
+
 	// RemoveUserFromRoom removes a user from a chat room with the given short code and user name.
+	// Other users in the chat room receive an EventTypeUserLeft event, unless the user is still connected through another application instance.
 	RemoveUserFromRoom(shortCode string, userName string) error
 
-	// GetRoomUsers retrieves the list of user names currently in a chat room with the provided short code.
+	// KickUser removes the target user from a chat room with the given short code and invalidates their chat token until they join the room again.
+	// Only the owner and moderators of the chat room can kick users, provided the target user has a lower role. Users in the chat room, including the kicked one, receive an EventTypeUserKicked event,
+	// after which the message queue of the kicked user is closed with ErrUserKicked.
+	KickUser(shortCode, userName, target string) error
+
+	// BanUser kicks the target user from a chat room with the given short code, if they are in it, and prevents them from joining it again until they are unbanned.
+	// Only the owner of the chat room can ban users. Users in the chat room, including the banned one, receive an EventTypeUserBanned event,
+	// after which the message queue of the banned user is closed with ErrUserBanned.
+	BanUser(shortCode, userName, target string) error
+
+	// UnbanUser lets the target user, who has been banned, join a chat room with the given short code again.
+	// Only the owner of the chat room can unban users.
+	UnbanUser(shortCode, userName, target string) error
+
+	// SetUserRole changes the role of the target user, who must be a member of a chat room with the given short code, to RoomRoleModerator, RoomRoleMember or RoomRoleReadOnly.
+	// Only the owner of the chat room can make users moderators or change the role of moderators, while moderators can also mute members by making them read-only and unmute them.
+	// Users in the chat room receive an EventTypeRoleChanged event.
+	SetUserRole(shortCode, userName, target string, role RoomRole) error
+
+	// GetUserRole retrieves the role of the user in a chat room with the given short code.
+	// Users who are not members of the chat room have the RoomRoleMember role, which they get once they join it.
+	GetUserRole(shortCode, userName string) (RoomRole, error)
+
+	// CheckUserAccess checks if the user can still use the chat token issued for a chat room with the given short code.
+	// It returns ErrUserBanned if the user is banned from the chat room and ErrUserKicked if they have been kicked from it and have not joined it again.
+	CheckUserAccess(shortCode, userName string) error
+
+	// GetRoomUsers retrieves the list of user names currently in a chat room with the provided short code, connected through any application instance.
 	GetRoomUsers(shortCode string) ([]string, error)
 
-	// IsUserInRoom checks if a user with the given user name is in the chat room with the provided short code.
+	// IsUserInRoom checks if a user with the given user name is in the chat room with the provided short code, connected through this application instance.
 	IsUserInRoom(shortCode string, userName string) (bool, error)
 
 	// BroadcastMessageToRoom broadcasts a message to all users in a chat room with the given short code.
+	// Users with the RoomRoleReadOnly role cannot send messages; ErrInsufficientRole is returned for them.
+	// If the message has a recipient, it is sent only to them, provided they are in the chat room; otherwise ErrRecipientNotFound is returned.
+	// If the message replies to another message, that message must be visible to the sender; otherwise ErrMessageNotFound is returned.
+	// It assigns the message its ID, sequence number and timestamp, stores it and publishes it to all application instances.
+	// The message is delivered to users asynchronously, never blocking on a full message queue; such users are handled according to the room's slow consumer policy.
 	BroadcastMessageToRoom(shortCode string, message *Message) error
 
-	// GetUserMessage retrieves a message from a user's message queue in a chat room.
-	GetUserMessage(shortCode string, userName string) (*Message, error)
+	// BroadcastAnnouncement sends an announcement with the given body on behalf of the user to all users in all chat rooms, connected through any application instance.
+	// Users receive an EventTypeAnnouncement event. The announcement is not stored in the history of any chat room.
+	BroadcastAnnouncement(userName, body string) error
+
+	// EditMessage changes the body of the message with the given ID in a chat room with the given short code.
+	// Only the sender of the message and the owner of the chat room can edit it. Users who can see the message receive an EventTypeMessageEdited event.
+	EditMessage(shortCode, userName, messageID, body string) error
+
+	// DeleteMessage deletes the message with the given ID from a chat room with the given short code.
+	// Only the sender of the message and the owner and moderators of the chat room can delete it. Users who can see the message receive an EventTypeMessageDeleted event.
+	DeleteMessage(shortCode, userName, messageID string) error
+
+	// AddReaction adds the user's reaction with the emoji to the message with the given ID in a chat room with the given short code.
+	// Users who can see the message receive an EventTypeReactionChanged event with the number of users who reacted with the emoji, unless the user has already reacted with it.
+	// Users with the RoomRoleReadOnly role cannot react to messages; ErrInsufficientRole is returned for them.
+	AddReaction(shortCode, userName, messageID, emoji string) error
+
+	// RemoveReaction withdraws the user's reaction with the emoji to the message with the given ID in a chat room with the given short code.
+	// Users who can see the message receive an EventTypeReactionChanged event with the number of users who reacted with the emoji, unless the user has not reacted with it.
+	RemoveReaction(shortCode, userName, messageID, emoji string) error
+
+	// SetUserTyping notifies other users in a chat room with the given short code that the user has started or stopped typing.
+	// The notification is not stored. Typing stops automatically if the user does not stop it within a few seconds.
+	SetUserTyping(shortCode string, userName string, typing bool) error
+
+	// MarkMessagesRead moves the user's read cursor in a chat room with the given short code to the message with the given sequence number.
+	// Other users in the chat room receive an EventTypeReadReceipt event, unless the cursor is already at or past the sequence number.
+	// If no message with the sequence number has been sent in the chat room yet, ErrInvalidReadSequence is returned.
+	MarkMessagesRead(shortCode string, userName string, sequence uint64) error
+
+	// GetUnreadCounts retrieves the number of unread messages in each chat room the user is a member of, ordered by short code.
+	GetUnreadCounts(userName string) ([]*UnreadCount, error)
+
+	// GetUserEvent retrieves an event from a user's message queue in a chat room.
+	// Once the queue is closed, it returns ErrUserMessageQueueClosed or the reason the user has been removed from the room, e.g. ErrSlowConsumer.
+	GetUserEvent(shortCode string, userName string) (*Event, error)
+
+	// GetSlowConsumerMetrics retrieves the counters of actions taken because of slow consumers.
+	GetSlowConsumerMetrics() SlowConsumerMetrics
+
+	// GetChatHistory retrieves up to limit messages previously broadcast to a chat room with the given short code, newest first.
+	// Only messages with an ID lower than the cursor are returned. If the cursor is 0, the newest messages are returned.
+	// Private messages are returned only to their sender and recipient. Messages are returned together with their reaction counts.
+	GetChatHistory(shortCode, userName string, cursor, limit int) ([]*model.Message, error)
 }
 
 // RoomServiceImpl implements the RoomService interface.
+// Changes of chat rooms and broadcast messages are published through a broker and applied from its subscription,
+// so that users connected to different application instances can talk in the same chat room.
 type RoomServiceImpl struct {
-	mu                  sync.RWMutex
-	rooms               map[string]*room
+	mu    sync.RWMutex
+	rooms map[string]*room
+	// deletedRooms holds deleted rooms, until their users have received all queued events.
+	deletedRooms        map[string]*room
 	maxMessageQueueSize int
+	replayBufferSize    int
+	slowConsumerPolicy  SlowConsumerPolicy
+	typingTimeout       time.Duration
+	instance            string
+	broker              broker.Broker
+	roomRepository      repository.RoomRepository
+	messageRepository   repository.MessageRepository
+	reactionRepository  repository.ReactionRepository
+	// roomMemberRepository stores the members of chat rooms together with their roles, while readCursorRepository stores how far they have read.
+	roomMemberRepository repository.RoomMemberRepository
+	readCursorRepository repository.ReadCursorRepository
+	banRepository        repository.BanRepository
+	// ownerSuccessionPolicy decides what happens to rooms left by their owners, which are deleted after ownerSuccessionGracePeriod under OwnerSuccessionPolicyDelete.
+	ownerSuccessionPolicy      OwnerSuccessionPolicy
+	ownerSuccessionGracePeriod time.Duration
+	// reaperInterval is the time between deletions of expired rooms and rooms with no users present in them for longer than idleTimeout, unless it is zero.
+	reaperInterval time.Duration
+	idleTimeout    time.Duration
+
+	droppedMessages   atomic.Uint64
+	disconnectedUsers atomic.Uint64
 }
 
 type room struct {
-	shortCode string
-	name      string
-	password  string
-	owner     string
-	users     map[string]*user
+	shortCode   string
+	createdAt   time.Time
+	name        string
+	description string
+	password    string
+	owner       string
+	settings    RoomSettings
+	users       map[string]*user
+	// remoteUsers holds the names of users connected through other instances, together with the set of these instances.
+	remoteUsers map[string]map[string]struct{}
+	// reactions holds the names of users who reacted to messages, by message ID and emoji.
+	reactions map[string]map[string]map[string]struct{}
+	// typing holds the timers stopping the typing of users connected through this instance.
+	typing map[string]*time.Timer
+	// evicted holds users removed from the room by the server, e.g. for not keeping up with messages, until they have received all queued events and the reason.
+	evicted map[string]*user
+	// kicked holds the names of users kicked or banned from the room, whose chat tokens are invalid until they join the room again.
+	kicked map[string]struct{}
+	// banned holds the names of users banned from the room.
+	banned map[string]struct{}
+	// roles holds the roles of members of the room other than RoomRoleMember. The owner's role is given by the owner field.
+	roles map[string]RoomRole
+	// present holds the time users connected through any instance joined the room at, as seen by this instance.
+	present map[string]time.Time
+	// expiresAt is the time the room expires at. Zero time means the room never expires.
+	expiresAt time.Time
+	// lastActivity is the time, in Unix nanoseconds, a user last joined or left the room or a message was delivered in it.
+	lastActivity atomic.Int64
+	// deletionTimer deletes the room left by its owner under OwnerSuccessionPolicyDelete, unless stopped once the owner joins it again.
+	deletionTimer *time.Timer
+
+	// lastSequence is the sequence number of the last message delivered in the room.
+	lastSequence atomic.Uint64
+
+	// publishMu serializes publishing of messages, so they are published in the order of their sequence numbers.
+	publishMu sync.Mutex
+	// deliverMu serializes deliveries of messages to the room's users.
+	deliverMu    sync.Mutex
+	replayBuffer *replayBuffer
 }
 
+// user's message queue is written to and closed only while holding the RoomServiceImpl's mutex.
 type user struct {
 	name         string
-	messageQueue chan *Message
+	messageQueue chan *Event
+	// missed is the number of events dropped from or not added to the full queue, not yet reported to the user.
+	missed atomic.Uint64
+	// err is the reason the message queue has been closed, if other than the user leaving the room.
+	err error
+}
+
+// NewRoomService creates a new RoomServiceImpl instance with the provided maximum message queue size, replay buffer size, default slow consumer policy, eventBroker, roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository and options.
+// It loads all chat rooms previously stored in the roomRepository, together with their most recent messages, reactions, bans and roles of members, and subscribes to the eventBroker until it is closed.
+func NewRoomService(maxMessageQueueSize, replayBufferSize int, slowConsumerPolicy SlowConsumerPolicy, eventBroker broker.Broker, roomRepository repository.RoomRepository, messageRepository repository.MessageRepository, reactionRepository repository.ReactionRepository, readCursorRepository repository.ReadCursorRepository, roomMemberRepository repository.RoomMemberRepository, banRepository repository.BanRepository, opts ...RoomServiceOpt) (*RoomServiceImpl, error) {
+	if slowConsumerPolicy == SlowConsumerPolicyDefault {
+		slowConsumerPolicy = SlowConsumerPolicyDropOldest
+	}
+
+	crs := &RoomServiceImpl{
+		maxMessageQueueSize:  maxMessageQueueSize,
+		replayBufferSize:     replayBufferSize,
+		slowConsumerPolicy:   slowConsumerPolicy,
+		typingTimeout:        typingTimeout,
+		rooms:                make(map[string]*room),
+		deletedRooms:         make(map[string]*room),
+		instance:             uuid.New().String(),
+		broker:               eventBroker,
+		roomRepository:       roomRepository,
+		messageRepository:    messageRepository,
+		reactionRepository:   reactionRepository,
+		readCursorRepository: readCursorRepository,
+		roomMemberRepository: roomMemberRepository,
+		banRepository:        banRepository,
+		reaperInterval:       defaultReaperInterval,
+	}
+
+	for _, opt := range opts {
+		opt(crs)
+	}
+
+	// Subscribing before loading the rooms makes sure no change made by other instances in the meantime is missed.
+	events, err := eventBroker.Subscribe(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	storedRooms, err := roomRepository.GetAllRooms(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rooms: %w", err)
+	}
+
+	for _, storedRoom := range storedRooms {
+		room, err := crs.newRoom(storedRoom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load room %s: %w", storedRoom.ShortCode, err)
+		}
+
+		if replayBufferSize > 0 {
+			lastMessages, err := messageRepository.GetMessages(context.Background(), storedRoom.ShortCode, "", 0, replayBufferSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load last messages of room %s: %w", storedRoom.ShortCode, err)
+			}
+
+			for i := len(lastMessages) - 1; i >= 0; i-- {
+				room.replayBuffer.add(newMessage(lastMessages[i]))
+			}
+		}
+
+		reactions, err := reactionRepository.GetReactions(context.Background(), storedRoom.ShortCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reactions of room %s: %w", storedRoom.ShortCode, err)
+		}
+
+		for _, reaction := range reactions {
+			room.addReaction(reaction.MessageID, reaction.Emoji, reaction.UserName)
+		}
+
+		bans, err := banRepository.GetBans(context.Background(), storedRoom.ShortCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bans of room %s: %w", storedRoom.ShortCode, err)
+		}
+
+		for _, ban := range bans {
+			room.banned[ban.UserName] = struct{}{}
+		}
+
+		members, err := roomMemberRepository.GetRoomMembers(context.Background(), storedRoom.ShortCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load members of room %s: %w", storedRoom.ShortCode, err)
+		}
+
+		for _, member := range members {
+			role, err := ParseRoomRole(member.Role)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load role of user %s in room %s: %w", member.UserName, storedRoom.ShortCode, err)
+			}
+			if role != RoomRoleMember {
+				room.roles[member.UserName] = role
+			}
+		}
+
+		crs.rooms[storedRoom.ShortCode] = room
+	}
+
+	// Rooms are reaped until the subscription ends, once the broker is closed.
+	subscriptionDone := make(chan struct{})
+	go func() {
+		defer close(subscriptionDone)
+		crs.handleEvents(events)
+	}()
+	if crs.reaperInterval > 0 {
+		go crs.reapRooms(subscriptionDone)
+	}
+
+	// Other instances announce their users in response.
+	if err := eventBroker.Publish(context.Background(), &broker.Event{
+		Type:     broker.EventTypeInstanceStarted,
+		Instance: crs.instance,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to announce instance: %w", err)
+	}
+
+	return crs, nil
+}
+
+// RoomServiceOpt represents an option that can be passed to NewRoomService.
+type RoomServiceOpt func(*RoomServiceImpl)
+
+// WithRoomReaper sets the time between deletions of expired chat rooms and the time after which chat rooms no user has been present in are deleted.
+// A zero idle timeout keeps such chat rooms, while a zero interval disables deleting chat rooms altogether, including the expired ones.
+func WithRoomReaper(interval, idleTimeout time.Duration) RoomServiceOpt {
+	return func(crs *RoomServiceImpl) {
+		crs.reaperInterval = interval
+		crs.idleTimeout = idleTimeout
+	}
+}
+
+// WithOwnerSuccession sets what happens to chat rooms left by their owners.
+// The grace period is the time after which such rooms are deleted under OwnerSuccessionPolicyDelete.
+func WithOwnerSuccession(policy OwnerSuccessionPolicy, gracePeriod time.Duration) RoomServiceOpt {
+	return func(crs *RoomServiceImpl) {
+		crs.ownerSuccessionPolicy = policy
+		crs.ownerSuccessionGracePeriod = gracePeriod
+	}
+}
+
+// newRoom creates a room with no users from the stored room.
+func (crs *RoomServiceImpl) newRoom(storedRoom *model.Room) (*room, error) {
+	policy, err := ParseSlowConsumerPolicy(storedRoom.SlowConsumerPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	room := &room{
+		shortCode:   storedRoom.ShortCode,
+		createdAt:   storedRoom.CreatedAt,
+		name:        storedRoom.Name,
+		description: storedRoom.Description,
+		password:    storedRoom.Password,
+		owner:       storedRoom.Owner,
+		settings: RoomSettings{
+			SlowConsumerPolicy: policy,
+			Public:             storedRoom.Public,
+		},
+		users:        make(map[string]*user),
+		remoteUsers:  make(map[string]map[string]struct{}),
+		reactions:    make(map[string]map[string]map[string]struct{}),
+		typing:       make(map[string]*time.Timer),
+		evicted:      make(map[string]*user),
+		kicked:       make(map[string]struct{}),
+		banned:       make(map[string]struct{}),
+		roles:        make(map[string]RoomRole),
+		present:      make(map[string]time.Time),
+		replayBuffer: newReplayBuffer(crs.replayBufferSize),
+	}
+	room.lastSequence.Store(storedRoom.LastSequence)
+	room.lastActivity.Store(time.Now().UnixNano())
+	if storedRoom.ExpiresAt != nil {
+		room.expiresAt = *storedRoom.ExpiresAt
+	}
+
+	return room, nil
 }
 
-// NewRoomService creates a new RoomServiceImpl instance with the provided maximum message queue size.
-func NewRoomService(maxMessageQueueSize int) *RoomServiceImpl {
-	return &RoomServiceImpl{
-		maxMessageQueueSize: maxMessageQueueSize,
-		rooms:               make(map[string]*room),
+// newMessage creates a message from the stored message.
+func newMessage(storedMessage *model.Message) *Message {
+	message := &Message{
+		ID:        storedMessage.MessageID,
+		Sequence:  storedMessage.Sequence,
+		Timestamp: storedMessage.CreatedAt,
+		Sender:    storedMessage.Sender,
+		Body:      storedMessage.Body,
+		Recipient: storedMessage.Recipient,
+		ReplyTo:   storedMessage.ReplyTo,
 	}
+
+	if storedMessage.EditedAt != nil {
+		message.EditedAt = *storedMessage.EditedAt
+	}
+
+	return message
 }
 
 func (crs *RoomServiceImpl) RoomExists(shortCode string) bool {
@@ -122,12 +621,15 @@ func (crs *RoomServiceImpl) CheckPassword(shortCode, password string) error {
 	return nil
 }
 
-func (crs *RoomServiceImpl) CreateRoom(shortCode, name, password, owner string) error {
-	crs.mu.Lock()
-	defer crs.mu.Unlock()
-
-	if _, ok := crs.rooms[shortCode]; ok {
-		return ErrRoomAlreadyExist
+func (crs *RoomServiceImpl) CreateRoom(shortCode, name, password, owner string, settings RoomSettings) error {
+	if settings.SlowConsumerPolicy == SlowConsumerPolicyDefault {
+		settings.SlowConsumerPolicy = crs.slowConsumerPolicy
+	}
+	if _, ok := slowConsumerPolicyNames[settings.SlowConsumerPolicy]; !ok {
+		return ErrInvalidSlowConsumerPolicy
+	}
+	if settings.TTL < 0 {
+		return ErrInvalidRoomTTL
 	}
 
 	hashedPassword, err := crypto.HashPassword(password)
@@ -135,40 +637,133 @@ func (crs *RoomServiceImpl) CreateRoom(shortCode, name, password, owner string)
 		return err
 	}
 
-	crs.rooms[shortCode] = &room{
-		shortCode: shortCode,
-		name:      name,
-		password:  hashedPassword,
-		owner:     owner,
-		users:     make(map[string]*user),
+	newRoom := &model.Room{
+		ShortCode: shortCode,
+		CreatedAt: time.Now(),
+		Name:      name,
+		Password:  hashedPassword,
+		Owner:     owner,
+
+		SlowConsumerPolicy: settings.SlowConsumerPolicy.String(),
+		Public:             settings.Public,
+	}
+	if settings.TTL > 0 {
+		expiresAt := newRoom.CreatedAt.Add(settings.TTL)
+		newRoom.ExpiresAt = &expiresAt
+	}
+
+	if err := crs.addRoom(newRoom); err != nil {
+		return err
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeRoomCreated,
+		ShortCode: shortCode,
+	}); err != nil {
+		return fmt.Errorf("failed to publish room creation: %w", err)
 	}
 
 	return nil
 }
 
-func (crs *RoomServiceImpl) DeleteRoom(shortCode, userName string) error {
-	crs.mu.Lock()
-	defer crs.mu.Unlock()
+func (crs *RoomServiceImpl) ListPublicRooms(nameFilter, cursor string, limit int) ([]*RoomInfo, error) {
+	return crs.listRooms(nameFilter, cursor, limit, true), nil
+}
+
+func (crs *RoomServiceImpl) ListRooms(nameFilter, cursor string, limit int) ([]*RoomInfo, error) {
+	return crs.listRooms(nameFilter, cursor, limit, false), nil
+}
+
+// listRooms retrieves a page of rooms whose names contain nameFilter, skipping private ones if publicOnly is true.
+func (crs *RoomServiceImpl) listRooms(nameFilter, cursor string, limit int, publicOnly bool) []*RoomInfo {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	nameFilter = strings.ToLower(nameFilter)
+
+	rooms := []*RoomInfo{}
+	for shortCode, room := range crs.rooms {
+		if (publicOnly && !room.settings.Public) || shortCode <= cursor || !strings.Contains(strings.ToLower(room.name), nameFilter) {
+			continue
+		}
+
+		rooms = append(rooms, crs.newRoomInfo(room))
+	}
+
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].ShortCode < rooms[j].ShortCode
+	})
+
+	if len(rooms) > limit {
+		rooms = rooms[:limit]
+	}
+
+	return rooms
+}
+
+// newRoomInfo creates the information about the room shown to users.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (crs *RoomServiceImpl) newRoomInfo(room *room) *RoomInfo {
+	memberCount := len(room.users)
+	for userName := range room.remoteUsers {
+		if _, ok := room.users[userName]; !ok {
+			memberCount++
+		}
+	}
+
+	return &RoomInfo{
+		ShortCode:   room.shortCode,
+		Name:        room.name,
+		Description: room.description,
+		Owner:       room.owner,
+		CreatedAt:   room.createdAt,
+		Settings:    room.settings,
+		MemberCount: memberCount,
+		ExpiresAt:   room.expiresAt,
+	}
+}
+
+func (crs *RoomServiceImpl) GetRoomInfo(shortCode string) (*RoomInfo, error) {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
 
 	room, ok := crs.rooms[shortCode]
 	if !ok {
-		return ErrRoomDoesNotExist
+		return nil, ErrRoomDoesNotExist
 	}
 
-	if room.owner != userName {
-		return ErrNotOwner
+	return crs.newRoomInfo(room), nil
+}
+
+func (crs *RoomServiceImpl) UpdateRoom(shortCode, userName string, update RoomUpdate) error {
+	if update.Name != nil && strings.TrimSpace(*update.Name) == "" {
+		return ErrInvalidRoomName
 	}
 
-	for _, user := range room.users {
-		close(user.messageQueue)
+	// Hashing the password is slow, so it is done before locking the rooms.
+	var hashedPassword string
+	if update.Password != nil {
+		var err error
+		if hashedPassword, err = crypto.HashPassword(*update.Password); err != nil {
+			return err
+		}
 	}
 
-	delete(crs.rooms, shortCode)
+	if err := crs.updateRoom(shortCode, userName, update, hashedPassword); err != nil {
+		return err
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeRoomUpdated,
+		ShortCode: shortCode,
+	}); err != nil {
+		return fmt.Errorf("failed to publish room update: %w", err)
+	}
 
 	return nil
 }
 
-func (crs *RoomServiceImpl) AddUserToRoom(shortCode string, userName string) error {
+func (crs *RoomServiceImpl) updateRoom(shortCode, userName string, update RoomUpdate, hashedPassword string) error {
 	crs.mu.Lock()
 	defer crs.mu.Unlock()
 
@@ -177,106 +772,1971 @@ func (crs *RoomServiceImpl) AddUserToRoom(shortCode string, userName string) err
 		return ErrRoomDoesNotExist
 	}
 
-	if _, ok := room.users[userName]; ok {
-		return ErrUserAlreadyExists
+	if room.owner != userName {
+		return ErrNotOwner
 	}
 
-	room.users[userName] = &user{
-		name:         userName,
-		messageQueue: make(chan *Message, crs.maxMessageQueueSize),
+	updatedRoom := &model.Room{
+		ShortCode:   shortCode,
+		Name:        room.name,
+		Description: room.description,
+		Password:    room.password,
+	}
+	if update.Name != nil {
+		updatedRoom.Name = *update.Name
+	}
+	if update.Description != nil {
+		updatedRoom.Description = *update.Description
+	}
+	if update.Password != nil {
+		updatedRoom.Password = hashedPassword
+	}
+
+	if err := crs.roomRepository.UpdateRoom(context.Background(), updatedRoom); err != nil {
+		return fmt.Errorf("failed to update stored room: %w", err)
 	}
 
+	room.name, room.description, room.password = updatedRoom.Name, updatedRoom.Description, updatedRoom.Password
+
 	return nil
 }
 
-func (crs *RoomServiceImpl) RemoveUserFromRoom(shortCode string, userName string) error {
+// addRoom stores the room and adds it to the rooms of this instance.
+func (crs *RoomServiceImpl) addRoom(newRoom *model.Room) error {
 	crs.mu.Lock()
 	defer crs.mu.Unlock()
 
-	room, ok := crs.rooms[shortCode]
-	if !ok {
-		return ErrRoomDoesNotExist
+	if _, ok := crs.rooms[newRoom.ShortCode]; ok {
+		return ErrRoomAlreadyExist
 	}
 
-	client, ok := room.users[userName]
-	if !ok {
-		return ErrUserNotFound
+	storedRoom, err := crs.roomRepository.AddRoom(context.Background(), newRoom)
+	if err != nil {
+		return fmt.Errorf("failed to store room: %w", err)
+	}
+
+	room, err := crs.newRoom(storedRoom)
+	if err != nil {
+		return err
 	}
 
-	close(client.messageQueue)
-	delete(room.users, userName)
+	crs.rooms[storedRoom.ShortCode] = room
 
 	return nil
 }
 
-func (crs *RoomServiceImpl) GetRoomUsers(shortCode string) ([]string, error) {
-	crs.mu.RLock()
-	defer crs.mu.RUnlock()
-
-	room, ok := crs.rooms[shortCode]
-	if !ok {
-		return nil, ErrRoomDoesNotExist
+func (crs *RoomServiceImpl) DeleteRoom(shortCode, userName string) error {
+	if err := crs.deleteRoom(shortCode, userName, false); err != nil {
+		return err
 	}
 
-	var users []string
-	for userName := range room.users {
-		users = append(users, userName)
+	return crs.publishRoomDeletion(shortCode)
+}
+
+func (crs *RoomServiceImpl) ForceDeleteRoom(shortCode string) error {
+	if err := crs.deleteRoom(shortCode, "", true); err != nil {
+		return err
 	}
 
-	return users, nil
+	return crs.publishRoomDeletion(shortCode)
 }
 
-func (crs *RoomServiceImpl) IsUserInRoom(shortCode string, userName string) (bool, error) {
-	crs.mu.RLock()
-	defer crs.mu.RUnlock()
-
-	room, ok := crs.rooms[shortCode]
-	if !ok {
-		return false, ErrRoomDoesNotExist
+// publishRoomDeletion publishes the deletion of the room, so that other instances remove it too.
+func (crs *RoomServiceImpl) publishRoomDeletion(shortCode string) error {
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeRoomDeleted,
+		ShortCode: shortCode,
+	}); err != nil {
+		return fmt.Errorf("failed to publish room deletion: %w", err)
 	}
 
-	_, ok = room.users[userName]
-	return ok, nil
+	return nil
 }
 
-func (crs *RoomServiceImpl) BroadcastMessageToRoom(shortCode string, message *Message) error {
-	crs.mu.RLock()
-	defer crs.mu.RUnlock()
+// deleteRoom deletes the stored room and removes it from the rooms of this instance.
+// Unless force is true, the user must be the owner of the room.
+func (crs *RoomServiceImpl) deleteRoom(shortCode, userName string, force bool) error {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
 
 	room, ok := crs.rooms[shortCode]
 	if !ok {
 		return ErrRoomDoesNotExist
 	}
 
-	for _, user := range room.users {
-		if user.name != message.Sender {
-			user.messageQueue <- message
-		}
+	if !force && room.owner != userName {
+		return ErrNotOwner
+	}
+
+	if err := crs.roomRepository.DeleteRoom(context.Background(), shortCode); err != nil {
+		return fmt.Errorf("failed to delete stored room: %w", err)
 	}
 
+	crs.closeRoom(room)
+
 	return nil
 }
 
-func (crs *RoomServiceImpl) GetUserMessage(shortCode string, userName string) (*Message, error) {
+func (crs *RoomServiceImpl) TransferOwnership(shortCode, userName, newOwner string) error {
 	crs.mu.RLock()
-
 	room, ok := crs.rooms[shortCode]
 	if !ok {
 		crs.mu.RUnlock()
-		return nil, ErrRoomDoesNotExist
+		return ErrRoomDoesNotExist
 	}
+	owner := room.owner
+	_, banned := room.banned[newOwner]
+	crs.mu.RUnlock()
 
-	user, ok := room.users[userName]
-	if !ok {
-		crs.mu.RUnlock()
-		return nil, ErrUserNotFound
+	if owner != userName {
+		return ErrNotOwner
 	}
 
-	crs.mu.RUnlock()
-	msg, ok := <-user.messageQueue
-	if !ok {
-		return nil, ErrUserMessageQueueClosed
+	if newOwner == owner {
+		return ErrAlreadyOwner
+	}
+
+	if banned {
+		return ErrUserBanned
+	}
+
+	return crs.transferOwnership(shortCode, owner, newOwner)
+}
+
+// transferOwnership stores the new owner of the room, who must be its member, and publishes the change.
+// The in-memory owner changes only once the change is received from the broker, so the stored owner decides between concurrent transfers:
+// ErrNotOwner is returned if the room is not owned by the owner anymore.
+func (crs *RoomServiceImpl) transferOwnership(shortCode, owner, newOwner string) error {
+	updated, err := crs.roomRepository.UpdateOwner(context.Background(), shortCode, owner, newOwner)
+	if err != nil {
+		return fmt.Errorf("failed to store owner: %w", err)
+	}
+	if !updated {
+		return ErrNotOwner
+	}
+
+	// Resetting the stored role makes the new owner a regular member once they hand the room over in turn.
+	updated, err = crs.roomMemberRepository.UpdateRole(context.Background(), shortCode, newOwner, RoomRoleMember.String())
+	if err != nil || !updated {
+		if _, restoreErr := crs.roomRepository.UpdateOwner(context.Background(), shortCode, newOwner, owner); restoreErr != nil {
+			logger.Error(fmt.Sprintf("Failed to restore owner [%s] of chat room with short code [%s]: %s", owner, shortCode, restoreErr))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to store role: %w", err)
+		}
+		return ErrUserNotFound
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeOwnerChanged,
+		ShortCode: shortCode,
+		UserName:  newOwner,
+	}); err != nil {
+		return fmt.Errorf("failed to publish ownership transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) AddUserToRoom(shortCode string, userName string) error {
+	lastSequence, err := crs.addUserToRoom(shortCode, userName)
+	if err != nil {
+		return err
+	}
+
+	if err := crs.roomMemberRepository.AddRoomMember(context.Background(), &model.RoomMember{
+		ShortCode: shortCode,
+		UserName:  userName,
+		Role:      RoomRoleMember.String(),
+		CreatedAt: time.Now(),
+	}); err != nil {
+		_ = crs.removeUserFromRoom(shortCode, userName)
+		return fmt.Errorf("failed to store room member: %w", err)
+	}
+
+	if err := crs.readCursorRepository.AddReadCursor(context.Background(), &model.ReadCursor{
+		ShortCode:        shortCode,
+		UserName:         userName,
+		LastReadSequence: lastSequence,
+		UpdatedAt:        time.Now(),
+	}); err != nil {
+		_ = crs.removeUserFromRoom(shortCode, userName)
+		return fmt.Errorf("failed to store read cursor: %w", err)
+	}
+
+	crs.publishMembership(broker.EventTypeUserJoined, shortCode, userName)
+
+	return nil
+}
+
+// addUserToRoom adds the user to the room and returns the sequence number of the last message delivered in the room before.
+func (crs *RoomServiceImpl) addUserToRoom(shortCode string, userName string) (uint64, error) {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return 0, ErrRoomDoesNotExist
+	}
+
+	if _, ok := room.banned[userName]; ok {
+		return 0, ErrUserBanned
+	}
+
+	if _, ok := room.users[userName]; ok {
+		return 0, ErrUserAlreadyExists
+	}
+
+	delete(room.evicted, userName)
+	delete(room.kicked, userName)
+	room.users[userName] = &user{
+		name:         userName,
+		messageQueue: make(chan *Event, crs.maxMessageQueueSize),
+	}
+
+	return room.lastSequence.Load(), nil
+}
+
+func (crs *RoomServiceImpl) ResumeUserInRoom(shortCode string, userName string, lastSequence uint64) error {
+	if err := crs.resumeUserInRoom(shortCode, userName, lastSequence); err != nil {
+		return err
+	}
+
+	crs.publishMembership(broker.EventTypeUserJoined, shortCode, userName)
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) resumeUserInRoom(shortCode string, userName string, lastSequence uint64) error {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	crs.mu.RUnlock()
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	// Holding the deliver lock guarantees that no message is delivered between the replay and joining the room.
+	room.deliverMu.Lock()
+	defer room.deliverMu.Unlock()
+
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	if crs.rooms[shortCode] != room {
+		return ErrRoomDoesNotExist
+	}
+
+	if err := room.checkAccess(userName); err != nil {
+		return err
+	}
+
+	if _, ok := room.users[userName]; ok {
+		return ErrUserAlreadyExists
+	}
+
+	missed := room.replayBuffer.since(lastSequence)
+
+	messageQueue := make(chan *Event, crs.maxMessageQueueSize+len(missed))
+	for _, message := range missed {
+		if message.Sender != userName && isVisibleTo(message.Sender, message.Recipient, userName) {
+			messageQueue <- newMessageEvent(message)
+		}
+	}
+
+	delete(room.evicted, userName)
+	room.users[userName] = &user{
+		name:         userName,
+		messageQueue: messageQueue,
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) RemoveUserFromRoom(shortCode string, userName string) error {
+	if err := crs.removeUserFromRoom(shortCode, userName); err != nil {
+		return err
+	}
+
+	crs.publishMembership(broker.EventTypeUserLeft, shortCode, userName)
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) removeUserFromRoom(shortCode string, userName string) error {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	user, ok := room.users[userName]
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	room.removeUser(user, nil)
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) KickUser(shortCode, userName, target string) error {
+	if err := crs.checkRemoval(shortCode, userName, target, RoomRoleModerator, true); err != nil {
+		return err
+	}
+
+	return crs.publishRemoval(broker.EventTypeUserKicked, shortCode, target)
+}
+
+func (crs *RoomServiceImpl) BanUser(shortCode, userName, target string) error {
+	if err := crs.checkRemoval(shortCode, userName, target, RoomRoleOwner, false); err != nil {
+		return err
+	}
+
+	added, err := crs.banRepository.AddBan(context.Background(), &model.Ban{
+		ShortCode: shortCode,
+		UserName:  target,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store ban: %w", err)
+	}
+
+	// The user has already been banned and removed from the room.
+	if !added {
+		return nil
+	}
+
+	return crs.publishRemoval(broker.EventTypeUserBanned, shortCode, target)
+}
+
+func (crs *RoomServiceImpl) UnbanUser(shortCode, userName, target string) error {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.RUnlock()
+		return ErrRoomDoesNotExist
+	}
+	owner := room.owner
+	crs.mu.RUnlock()
+
+	if owner != userName {
+		return ErrNotOwner
+	}
+
+	deleted, err := crs.banRepository.DeleteBan(context.Background(), shortCode, target)
+	if err != nil {
+		return fmt.Errorf("failed to delete stored ban: %w", err)
+	}
+	if !deleted {
+		return ErrUserNotBanned
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeUserUnbanned,
+		ShortCode: shortCode,
+		UserName:  target,
+	}); err != nil {
+		return fmt.Errorf("failed to publish unban: %w", err)
+	}
+
+	return nil
+}
+
+// checkRemoval checks if the user, who must have at least the given role in the room, can kick or ban the target user from it.
+// If requirePresence is true, the target user must be in the room, connected through any instance.
+func (crs *RoomServiceImpl) checkRemoval(shortCode, userName, target string, minRole RoomRole, requirePresence bool) error {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	if err := room.checkRole(userName, minRole); err != nil {
+		return err
+	}
+
+	if target == room.owner {
+		return ErrCannotRemoveOwner
+	}
+
+	if room.role(userName) <= room.role(target) {
+		return ErrInsufficientRole
+	}
+
+	if requirePresence {
+		_, local := room.users[target]
+		_, remote := room.remoteUsers[target]
+		if !local && !remote {
+			return ErrUserNotFound
+		}
+	}
+
+	return nil
+}
+
+// publishRemoval publishes the kick or ban of the user, which is applied by all instances.
+func (crs *RoomServiceImpl) publishRemoval(eventType broker.EventType, shortCode, userName string) error {
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      eventType,
+		ShortCode: shortCode,
+		UserName:  userName,
+	}); err != nil {
+		return fmt.Errorf("failed to publish removal of user: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) SetUserRole(shortCode, userName, target string, role RoomRole) error {
+	if role < RoomRoleReadOnly || role > RoomRoleModerator {
+		return ErrInvalidRoomRole
+	}
+
+	if err := crs.checkRoleChange(shortCode, userName, target, role); err != nil {
+		return err
+	}
+
+	updated, err := crs.roomMemberRepository.UpdateRole(context.Background(), shortCode, target, role.String())
+	if err != nil {
+		return fmt.Errorf("failed to store role: %w", err)
+	}
+	if !updated {
+		return ErrUserNotFound
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeRoleChanged,
+		ShortCode: shortCode,
+		UserName:  target,
+		Role:      role.String(),
+	}); err != nil {
+		return fmt.Errorf("failed to publish role change: %w", err)
+	}
+
+	return nil
+}
+
+// checkRoleChange checks if the user can change the role of the target user in the room to the given role.
+func (crs *RoomServiceImpl) checkRoleChange(shortCode, userName, target string, role RoomRole) error {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	if target == room.owner {
+		return ErrOwnerRole
+	}
+
+	// Moderators can only mute and unmute users who are not moderators.
+	minRole := RoomRoleModerator
+	if role == RoomRoleModerator || room.role(target) == RoomRoleModerator {
+		minRole = RoomRoleOwner
+	}
+
+	return room.checkRole(userName, minRole)
+}
+
+func (crs *RoomServiceImpl) GetUserRole(shortCode, userName string) (RoomRole, error) {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return 0, ErrRoomDoesNotExist
+	}
+
+	return room.role(userName), nil
+}
+
+func (crs *RoomServiceImpl) CheckUserAccess(shortCode, userName string) error {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	return room.checkAccess(userName)
+}
+
+func (crs *RoomServiceImpl) GetRoomUsers(shortCode string) ([]string, error) {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return nil, ErrRoomDoesNotExist
+	}
+
+	var users []string
+	for userName := range room.users {
+		users = append(users, userName)
+	}
+	for userName := range room.remoteUsers {
+		if _, ok := room.users[userName]; !ok {
+			users = append(users, userName)
+		}
+	}
+
+	return users, nil
+}
+
+func (crs *RoomServiceImpl) IsUserInRoom(shortCode string, userName string) (bool, error) {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return false, ErrRoomDoesNotExist
+	}
+
+	_, ok = room.users[userName]
+	return ok, nil
+}
+
+func (crs *RoomServiceImpl) BroadcastMessageToRoom(shortCode string, message *Message) error {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.RUnlock()
+		return ErrRoomDoesNotExist
+	}
+	role := room.role(message.Sender)
+	crs.mu.RUnlock()
+
+	if role < RoomRoleMember {
+		return ErrInsufficientRole
+	}
+
+	// The body is checked before the message is stored, so that no message is assigned a sequence number it can never be delivered with.
+	if len(message.Body) > MaxMessageBodySize {
+		return ErrMessageTooLarge
+	}
+
+	if message.Recipient != "" {
+		if message.Recipient == message.Sender {
+			return ErrInvalidRecipient
+		}
+		if !crs.isMember(room, message.Recipient) {
+			return ErrRecipientNotFound
+		}
+	}
+
+	if message.ReplyTo != "" {
+		if _, err := crs.getVisibleMessage(shortCode, message.Sender, message.ReplyTo); err != nil {
+			return err
+		}
+	}
+
+	room.publishMu.Lock()
+	defer room.publishMu.Unlock()
+
+	// The room might have been deleted since it was looked up, in which case the stored room no longer accepts messages either.
+	crs.mu.RLock()
+	deleted := crs.rooms[shortCode] != room
+	crs.mu.RUnlock()
+	if deleted {
+		return ErrRoomDoesNotExist
+	}
+
+	storedMessage, err := crs.messageRepository.AddMessage(context.Background(), &model.Message{
+		MessageID: uuid.New().String(),
+		CreatedAt: time.Now(),
+		ShortCode: shortCode,
+		Sender:    message.Sender,
+		Body:      message.Body,
+		Recipient: message.Recipient,
+		ReplyTo:   message.ReplyTo,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store message: %w", err)
+	}
+	if storedMessage == nil {
+		return ErrRoomDoesNotExist
+	}
+
+	message.ID = storedMessage.MessageID
+	message.Sequence = storedMessage.Sequence
+	message.Timestamp = storedMessage.CreatedAt
+
+	// The room might have been deleted right after the message was stored, which deletes the message as well.
+	if !crs.RoomExists(shortCode) {
+		return ErrRoomDoesNotExist
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeMessage,
+		ShortCode: shortCode,
+		Message:   storedMessage,
+	}); err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) BroadcastAnnouncement(userName, body string) error {
+	if strings.TrimSpace(body) == "" {
+		return ErrInvalidAnnouncement
+	}
+	if len(body) > MaxMessageBodySize {
+		return ErrMessageTooLarge
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type: broker.EventTypeAnnouncement,
+		Message: &model.Message{
+			MessageID: uuid.New().String(),
+			CreatedAt: time.Now(),
+			Sender:    userName,
+			Body:      body,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to publish announcement: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) EditMessage(shortCode, userName, messageID, body string) error {
+	if len(body) > MaxMessageBodySize {
+		return ErrMessageTooLarge
+	}
+
+	storedMessage, err := crs.getModifiableMessage(shortCode, userName, messageID, RoomRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	editedAt := time.Now()
+	storedMessage.Body = body
+	storedMessage.EditedAt = &editedAt
+
+	if err := crs.messageRepository.UpdateMessage(context.Background(), storedMessage); err != nil {
+		return fmt.Errorf("failed to update message: %w", err)
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeMessageEdited,
+		ShortCode: shortCode,
+		Message:   storedMessage,
+	}); err != nil {
+		return fmt.Errorf("failed to publish message edit: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) DeleteMessage(shortCode, userName, messageID string) error {
+	storedMessage, err := crs.getModifiableMessage(shortCode, userName, messageID, RoomRoleModerator)
+	if err != nil {
+		return err
+	}
+
+	if err := crs.messageRepository.DeleteMessage(context.Background(), messageID); err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeMessageDeleted,
+		ShortCode: shortCode,
+		Message:   storedMessage,
+	}); err != nil {
+		return fmt.Errorf("failed to publish message deletion: %w", err)
+	}
+
+	return nil
+}
+
+// getModifiableMessage retrieves the stored message, provided the user is its sender or has at least the given role in the room.
+// Private messages of other users are reported as not found.
+func (crs *RoomServiceImpl) getModifiableMessage(shortCode, userName, messageID string, minRole RoomRole) (*model.Message, error) {
+	storedMessage, err := crs.getVisibleMessage(shortCode, userName, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.RUnlock()
+		return nil, ErrRoomDoesNotExist
+	}
+	role := room.role(userName)
+	crs.mu.RUnlock()
+
+	if storedMessage.Sender != userName && role < minRole {
+		return nil, ErrNotMessageSender
+	}
+
+	return storedMessage, nil
+}
+
+// getVisibleMessage retrieves the stored message, provided the user can see it.
+// Private messages of other users are reported as not found.
+func (crs *RoomServiceImpl) getVisibleMessage(shortCode, userName, messageID string) (*model.Message, error) {
+	if !crs.RoomExists(shortCode) {
+		return nil, ErrRoomDoesNotExist
+	}
+
+	if _, err := uuid.Parse(messageID); err != nil {
+		return nil, ErrMessageNotFound
+	}
+
+	storedMessage, err := crs.messageRepository.GetMessage(context.Background(), shortCode, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if storedMessage == nil || !isVisibleTo(storedMessage.Sender, storedMessage.Recipient, userName) {
+		return nil, ErrMessageNotFound
+	}
+
+	return storedMessage, nil
+}
+
+func (crs *RoomServiceImpl) AddReaction(shortCode, userName, messageID, emoji string) error {
+	return crs.react(shortCode, userName, messageID, emoji, true)
+}
+
+func (crs *RoomServiceImpl) RemoveReaction(shortCode, userName, messageID, emoji string) error {
+	return crs.react(shortCode, userName, messageID, emoji, false)
+}
+
+// react adds or withdraws the user's reaction to the message and publishes the change, if any.
+func (crs *RoomServiceImpl) react(shortCode, userName, messageID, emoji string, add bool) error {
+	if emoji == "" || utf8.RuneCountInString(emoji) > MaxReactionLength {
+		return ErrInvalidReaction
+	}
+
+	role, err := crs.GetUserRole(shortCode, userName)
+	if err != nil {
+		return err
+	}
+	if role < RoomRoleMember {
+		return ErrInsufficientRole
+	}
+
+	storedMessage, err := crs.getVisibleMessage(shortCode, userName, messageID)
+	if err != nil {
+		return err
+	}
+
+	reaction := &model.Reaction{
+		MessageID: messageID,
+		ShortCode: shortCode,
+		UserName:  userName,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}
+
+	eventType := broker.EventTypeReactionAdded
+	var changed bool
+	if add {
+		changed, err = crs.reactionRepository.AddReaction(context.Background(), reaction)
+	} else {
+		eventType = broker.EventTypeReactionRemoved
+		changed, err = crs.reactionRepository.DeleteReaction(context.Background(), reaction)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store reaction: %w", err)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      eventType,
+		ShortCode: shortCode,
+		UserName:  userName,
+		Message:   storedMessage,
+		Emoji:     emoji,
+	}); err != nil {
+		return fmt.Errorf("failed to publish reaction: %w", err)
+	}
+
+	return nil
+}
+
+// isVisibleTo checks if a message with the given sender and recipient can be seen by the user.
+func isVisibleTo(sender, recipient, userName string) bool {
+	return recipient == "" || recipient == userName || sender == userName
+}
+
+// handleEvents applies events received from the broker to the rooms of this instance until the subscription ends.
+func (crs *RoomServiceImpl) handleEvents(events <-chan *broker.Event) {
+	for event := range events {
+		switch event.Type {
+		case broker.EventTypeMessage:
+			if event.Message != nil {
+				crs.deliverMessage(event.ShortCode, newMessage(event.Message))
+			}
+		case broker.EventTypeMessageEdited, broker.EventTypeMessageDeleted:
+			if event.Message != nil {
+				crs.deliverMessageChange(event.ShortCode, newMessage(event.Message), event.Type == broker.EventTypeMessageDeleted)
+			}
+		case broker.EventTypeReactionAdded, broker.EventTypeReactionRemoved:
+			if event.Message != nil {
+				crs.deliverReaction(event.ShortCode, newMessage(event.Message), event.UserName, event.Emoji, event.Type == broker.EventTypeReactionAdded)
+			}
+		case broker.EventTypeMessagesRead:
+			crs.deliverReadReceipt(event.ShortCode, event.UserName, event.Sequence)
+		case broker.EventTypeRoomCreated:
+			crs.loadRoom(event.ShortCode)
+		case broker.EventTypeRoomUpdated:
+			crs.reloadRoom(event.ShortCode)
+		case broker.EventTypeRoomDeleted:
+			crs.unloadRoom(event.ShortCode)
+		case broker.EventTypeUserKicked, broker.EventTypeUserBanned:
+			crs.expelUser(event.ShortCode, event.UserName, event.Type == broker.EventTypeUserBanned)
+		case broker.EventTypeUserUnbanned:
+			crs.unbanUser(event.ShortCode, event.UserName)
+		case broker.EventTypeRoleChanged:
+			crs.deliverRoleChange(event.ShortCode, event.UserName, event.Role)
+		case broker.EventTypeOwnerChanged:
+			crs.changeOwner(event.ShortCode, event.UserName)
+		case broker.EventTypeUserJoined, broker.EventTypeUserLeft:
+			crs.updateMembership(event.ShortCode, event.UserName, event.Instance, event.Type == broker.EventTypeUserJoined)
+		case broker.EventTypeTyping:
+			crs.deliverTyping(event.ShortCode, event.UserName, event.Typing)
+		case broker.EventTypeAnnouncement:
+			if event.Message != nil {
+				crs.deliverAnnouncement(newMessage(event.Message))
+			}
+		case broker.EventTypeInstanceStarted:
+			if event.Instance != crs.instance {
+				crs.announceUsers()
+			}
+		}
+	}
+}
+
+// deliverMessage adds the message to the room's replay buffer and the message queues of all users in the room except the sender.
+// A private message is added only to the message queue of its recipient.
+// It never blocks on a full message queue; such users are handled according to the room's slow consumer policy.
+func (crs *RoomServiceImpl) deliverMessage(shortCode string, message *Message) {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	crs.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	room.deliverMu.Lock()
+	defer room.deliverMu.Unlock()
+
+	room.replayBuffer.add(message)
+
+	crs.mu.RLock()
+
+	if crs.rooms[shortCode] != room {
+		crs.mu.RUnlock()
+		return
+	}
+
+	// Updating it under the lock makes users joining the room either receive the message or consider it read.
+	if message.Sequence > room.lastSequence.Load() {
+		room.lastSequence.Store(message.Sequence)
+	}
+	room.lastActivity.Store(time.Now().UnixNano())
+
+	laggards := crs.deliverToUsers(room, func(userName string) bool {
+		return userName != message.Sender && (message.Recipient == "" || userName == message.Recipient)
+	}, newMessageEvent(message))
+
+	crs.mu.RUnlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// deliverMessageChange updates the message in the room's replay buffer and notifies all users in the room who can see the message about its edit or deletion.
+// It never blocks on a full message queue; such users are handled according to the room's slow consumer policy.
+func (crs *RoomServiceImpl) deliverMessageChange(shortCode string, message *Message, deleted bool) {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	crs.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	room.deliverMu.Lock()
+	defer room.deliverMu.Unlock()
+
+	event := &Event{
+		Type:      EventTypeMessageEdited,
+		Timestamp: message.EditedAt,
+		Message:   message,
+	}
+	if deleted {
+		crs.mu.Lock()
+		delete(room.reactions, message.ID)
+		crs.mu.Unlock()
+
+		room.replayBuffer.remove(message.ID)
+		event.Type, event.Timestamp = EventTypeMessageDeleted, time.Now()
+	} else {
+		room.replayBuffer.replace(message)
+	}
+
+	crs.mu.RLock()
+
+	if crs.rooms[shortCode] != room {
+		crs.mu.RUnlock()
+		return
+	}
+
+	laggards := crs.deliverToUsers(room, func(userName string) bool {
+		return isVisibleTo(message.Sender, message.Recipient, userName)
+	}, event)
+
+	crs.mu.RUnlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// deliverReaction applies the change of the user's reaction to the room's reaction counts
+// and notifies all users in the room who can see the message about the new count.
+func (crs *RoomServiceImpl) deliverReaction(shortCode string, message *Message, userName, emoji string, added bool) {
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	var changed bool
+	if added {
+		changed = room.addReaction(message.ID, emoji, userName)
+	} else {
+		changed = room.removeReaction(message.ID, emoji, userName)
+	}
+
+	var laggards []*user
+	if changed {
+		event := &Event{
+			Type:      EventTypeReactionChanged,
+			Timestamp: time.Now(),
+			Reaction: &Reaction{
+				MessageID: message.ID,
+				Emoji:     emoji,
+				UserName:  userName,
+				Added:     added,
+				Count:     len(room.reactions[message.ID][emoji]),
+			},
+		}
+
+		laggards = crs.deliverToUsers(room, func(name string) bool {
+			return isVisibleTo(message.Sender, message.Recipient, name)
+		}, event)
+	}
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// deliverReadReceipt notifies all users in the room, except the reader, that the user has read the messages up to the sequence number.
+func (crs *RoomServiceImpl) deliverReadReceipt(shortCode, userName string, sequence uint64) {
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	event := &Event{
+		Type:         EventTypeReadReceipt,
+		Timestamp:    time.Now(),
+		UserName:     userName,
+		ReadSequence: sequence,
+	}
+
+	laggards := crs.deliverToUsers(room, func(name string) bool {
+		return name != userName
+	}, event)
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// deliverAnnouncement adds the announcement to the message queues of all users in all rooms.
+// It never blocks on a full message queue; such users are handled according to their room's slow consumer policy.
+func (crs *RoomServiceImpl) deliverAnnouncement(message *Message) {
+	crs.mu.Lock()
+
+	event := &Event{
+		Type:      EventTypeAnnouncement,
+		Timestamp: message.Timestamp,
+		Message:   message,
+	}
+
+	laggards := map[*room][]*user{}
+	for _, room := range crs.rooms {
+		laggards[room] = crs.deliverToUsers(room, nil, event)
+	}
+
+	crs.mu.Unlock()
+
+	for room, users := range laggards {
+		crs.disconnectLaggards(room, users)
+	}
+}
+
+// deliverTyping adds the typing notification to the message queues of all users in the room except the typing one.
+// The notification is ephemeral, so it is dropped for users whose message queues are full.
+func (crs *RoomServiceImpl) deliverTyping(shortCode, userName string, typing bool) {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return
+	}
+
+	event := &Event{
+		Type:      EventTypeTyping,
+		Timestamp: time.Now(),
+		UserName:  userName,
+		Typing:    typing,
+	}
+
+	for _, user := range room.users {
+		if user.name == userName {
+			continue
+		}
+
+		select {
+		case user.messageQueue <- event:
+		default:
+		}
+	}
+}
+
+// loadRoom adds the room created by another instance to the rooms of this instance.
+func (crs *RoomServiceImpl) loadRoom(shortCode string) {
+	if crs.RoomExists(shortCode) {
+		return
+	}
+
+	storedRoom, err := crs.roomRepository.GetRoomByShortCode(context.Background(), shortCode)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to load chat room with short code [%s]: %s", shortCode, err))
+		return
+	}
+
+	// The room might have been deleted in the meantime.
+	if storedRoom == nil {
+		return
+	}
+
+	room, err := crs.newRoom(storedRoom)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to load chat room with short code [%s]: %s", shortCode, err))
+		return
+	}
+
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	if _, ok := crs.rooms[shortCode]; !ok {
+		crs.rooms[shortCode] = room
+	}
+}
+
+// updateMembership records that the user has joined or left the room through the instance.
+// Users connected through this instance are notified once the user joins the room through their first instance or leaves it through their last one.
+func (crs *RoomServiceImpl) updateMembership(shortCode, userName, instance string, joined bool) {
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	var wasMember bool
+	if instance == crs.instance {
+		// The room's users have already been updated, so the user was a member before leaving or if connected through another instance.
+		wasMember = !joined || len(room.remoteUsers[userName]) > 0
+	} else {
+		_, local := room.users[userName]
+		wasMember = local || len(room.remoteUsers[userName]) > 0
+	}
+
+	if instance != crs.instance {
+		if joined {
+			// Users kicked from the room can join it again, which makes their chat tokens valid on all instances.
+			delete(room.kicked, userName)
+			if _, ok := room.remoteUsers[userName]; !ok {
+				room.remoteUsers[userName] = make(map[string]struct{})
+			}
+			room.remoteUsers[userName][instance] = struct{}{}
+		} else {
+			delete(room.remoteUsers[userName], instance)
+			if len(room.remoteUsers[userName]) == 0 {
+				delete(room.remoteUsers, userName)
+			}
+		}
+	}
+
+	_, local := room.users[userName]
+	isMember := local || len(room.remoteUsers[userName]) > 0
+
+	if joined && !wasMember {
+		room.present[userName] = time.Now()
+	} else if !joined && wasMember && !isMember {
+		delete(room.present, userName)
+	}
+	room.lastActivity.Store(time.Now().UnixNano())
+
+	// The owner's leaving is handled by the instance they have left through last.
+	ownerLeft := false
+	if userName == room.owner {
+		if joined {
+			room.stopDeletionTimer()
+		} else {
+			ownerLeft = wasMember && !isMember && instance == crs.instance
+		}
+	}
+
+	var laggards []*user
+	if joined && !wasMember || !joined && wasMember && !isMember {
+		event := &Event{
+			Type:      EventTypeUserJoined,
+			Timestamp: time.Now(),
+			UserName:  userName,
+		}
+		if !joined {
+			event.Type = EventTypeUserLeft
+		}
+
+		laggards = crs.deliverToUsers(room, func(name string) bool {
+			return name != userName
+		}, event)
+	}
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+
+	if ownerLeft {
+		crs.succeedOwner(shortCode, userName)
+	}
+}
+
+// reapRooms deletes expired and idle rooms every reaper interval until done is closed.
+func (crs *RoomServiceImpl) reapRooms(done <-chan struct{}) {
+	ticker := time.NewTicker(crs.reaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			crs.reclaimRooms(now)
+		}
+	}
+}
+
+// reclaimRooms deletes the rooms that have expired or have had no users present in them for longer than the idle timeout.
+// Users in the deleted rooms receive an EventTypeRoomDeleted event, as if the owner deleted them.
+func (crs *RoomServiceImpl) reclaimRooms(now time.Time) {
+	type reclaimedRoom struct {
+		shortCode, reason string
+	}
+
+	crs.mu.RLock()
+	reclaimed := []reclaimedRoom{}
+	for shortCode, room := range crs.rooms {
+		switch {
+		case !room.expiresAt.IsZero() && !now.Before(room.expiresAt):
+			reclaimed = append(reclaimed, reclaimedRoom{shortCode, "expired"})
+		case crs.idleTimeout > 0 && len(room.present) == 0 && now.Sub(time.Unix(0, room.lastActivity.Load())) >= crs.idleTimeout:
+			reclaimed = append(reclaimed, reclaimedRoom{shortCode, "idle"})
+		}
+	}
+	crs.mu.RUnlock()
+
+	for _, room := range reclaimed {
+		if err := crs.ForceDeleteRoom(room.shortCode); err != nil {
+			// The room has been deleted by its owner or another instance in the meantime.
+			if errors.Is(err, ErrRoomDoesNotExist) {
+				continue
+			}
+
+			logger.Error(fmt.Sprintf("Failed to reclaim %s chat room with short code [%s]: %s", room.reason, room.shortCode, err))
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Reclaimed %s chat room with short code [%s]", room.reason, room.shortCode))
+	}
+}
+
+// succeedOwner applies the owner succession policy to the room left by its owner.
+func (crs *RoomServiceImpl) succeedOwner(shortCode, owner string) {
+	switch crs.ownerSuccessionPolicy {
+	case OwnerSuccessionPolicyPromote:
+		crs.mu.RLock()
+		room, ok := crs.rooms[shortCode]
+		if !ok || room.owner != owner {
+			crs.mu.RUnlock()
+			return
+		}
+		successor := room.longestPresentUser()
+		crs.mu.RUnlock()
+
+		if successor == "" {
+			logger.Info(fmt.Sprintf("No user to promote in chat room with short code [%s] left by its owner [%s]", shortCode, owner))
+			return
+		}
+
+		if err := crs.transferOwnership(shortCode, owner, successor); err != nil {
+			logger.Error(fmt.Sprintf("Failed to promote user [%s] to owner of chat room with short code [%s] left by its owner [%s]: %s", successor, shortCode, owner, err))
+			return
+		}
+
+		logger.Info(fmt.Sprintf("Promoted user [%s] to owner of chat room with short code [%s] left by its owner [%s]", successor, shortCode, owner))
+	case OwnerSuccessionPolicyDelete:
+		crs.mu.Lock()
+		defer crs.mu.Unlock()
+
+		room, ok := crs.rooms[shortCode]
+		if !ok || room.owner != owner {
+			return
+		}
+
+		room.stopDeletionTimer()
+		room.deletionTimer = time.AfterFunc(crs.ownerSuccessionGracePeriod, func() {
+			crs.deleteAbandonedRoom(shortCode, owner)
+		})
+
+		logger.Info(fmt.Sprintf("Scheduled deletion of chat room with short code [%s] left by its owner [%s] in [%s]", shortCode, owner, crs.ownerSuccessionGracePeriod))
+	}
+}
+
+// deleteAbandonedRoom deletes the room, unless its owner has joined it again or handed it over.
+func (crs *RoomServiceImpl) deleteAbandonedRoom(shortCode, owner string) {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.RUnlock()
+		return
+	}
+	_, present := room.present[owner]
+	abandoned := room.owner == owner && !present
+	crs.mu.RUnlock()
+
+	if !abandoned {
+		return
+	}
+
+	if err := crs.DeleteRoom(shortCode, owner); err != nil {
+		logger.Error(fmt.Sprintf("Failed to delete chat room with short code [%s] left by its owner [%s]: %s", shortCode, owner, err))
+		return
+	}
+
+	logger.Info(fmt.Sprintf("Deleted chat room with short code [%s] left by its owner [%s]", shortCode, owner))
+}
+
+// changeOwner applies the transfer of the room's ownership to the user and notifies all users in the room about it.
+// The previous owner becomes a regular member.
+func (crs *RoomServiceImpl) changeOwner(shortCode, newOwner string) {
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	room.owner = newOwner
+	delete(room.roles, newOwner)
+	room.stopDeletionTimer()
+
+	event := &Event{
+		Type:      EventTypeOwnerChanged,
+		Timestamp: time.Now(),
+		UserName:  newOwner,
+	}
+
+	laggards := crs.deliverToUsers(room, nil, event)
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// expelUser applies the kick or ban of the user from the room and notifies all users in the room about it.
+// If the user is connected through this instance, they receive the notification followed by the reason of the removal.
+func (crs *RoomServiceImpl) expelUser(shortCode, userName string, banned bool) {
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	event := &Event{
+		Type:      EventTypeUserKicked,
+		Timestamp: time.Now(),
+		UserName:  userName,
+	}
+	reason := ErrUserKicked
+	if banned {
+		event.Type, reason = EventTypeUserBanned, ErrUserBanned
+		room.banned[userName] = struct{}{}
+	}
+	room.kicked[userName] = struct{}{}
+
+	laggards := crs.deliverToUsers(room, nil, event)
+
+	expelled, local := room.users[userName]
+	if local {
+		room.removeUser(expelled, reason)
+		room.evicted[userName] = expelled
+	}
+
+	crs.mu.Unlock()
+
+	if local {
+		crs.publishMembership(broker.EventTypeUserLeft, shortCode, userName)
+	}
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// deliverRoleChange applies the change of the user's role in the room and notifies all users in the room about it.
+func (crs *RoomServiceImpl) deliverRoleChange(shortCode, userName, roleName string) {
+	role, err := ParseRoomRole(roleName)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to change role of user [%s] in chat room with short code [%s]: %s", userName, shortCode, err))
+		return
+	}
+
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	if role == RoomRoleMember {
+		delete(room.roles, userName)
+	} else {
+		room.roles[userName] = role
+	}
+
+	event := &Event{
+		Type:      EventTypeRoleChanged,
+		Timestamp: time.Now(),
+		UserName:  userName,
+		Role:      role,
+	}
+
+	laggards := crs.deliverToUsers(room, nil, event)
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// unbanUser lets the user join the room again.
+func (crs *RoomServiceImpl) unbanUser(shortCode, userName string) {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	if room, ok := crs.rooms[shortCode]; ok {
+		delete(room.banned, userName)
+	}
+}
+
+// announceUsers publishes the users connected through this instance for a newly started instance.
+func (crs *RoomServiceImpl) announceUsers() {
+	crs.mu.RLock()
+	events := []*broker.Event{}
+	for shortCode, room := range crs.rooms {
+		for userName := range room.users {
+			events = append(events, &broker.Event{
+				Type:      broker.EventTypeUserJoined,
+				Instance:  crs.instance,
+				ShortCode: shortCode,
+				UserName:  userName,
+			})
+		}
+	}
+	crs.mu.RUnlock()
+
+	for _, event := range events {
+		if err := crs.broker.Publish(context.Background(), event); err != nil {
+			logger.Error(fmt.Sprintf("Failed to announce user [%s] in chat room with short code [%s]: %s", event.UserName, event.ShortCode, err))
+		}
+	}
+}
+
+// publishMembership notifies other instances that the user has joined or left the room through this instance.
+// A failure only affects the list of users seen by other instances, so it is logged rather than returned.
+func (crs *RoomServiceImpl) publishMembership(eventType broker.EventType, shortCode, userName string) {
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      eventType,
+		Instance:  crs.instance,
+		ShortCode: shortCode,
+		UserName:  userName,
+	}); err != nil {
+		logger.Error(fmt.Sprintf("Failed to publish membership of user [%s] in chat room with short code [%s]: %s", userName, shortCode, err))
+	}
+}
+
+// isMember checks if the user is in the room, connected through any instance.
+func (crs *RoomServiceImpl) isMember(room *room, userName string) bool {
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	if _, ok := room.users[userName]; ok {
+		return true
+	}
+	_, ok := room.remoteUsers[userName]
+	return ok
+}
+
+// reloadRoom applies the update of the room stored by any instance and notifies all users in the room about it.
+func (crs *RoomServiceImpl) reloadRoom(shortCode string) {
+	storedRoom, err := crs.roomRepository.GetRoomByShortCode(context.Background(), shortCode)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to reload chat room with short code [%s]: %s", shortCode, err))
+		return
+	}
+
+	// The room might have been deleted in the meantime.
+	if storedRoom == nil {
+		return
+	}
+
+	crs.mu.Lock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		crs.mu.Unlock()
+		return
+	}
+
+	room.name, room.description, room.password = storedRoom.Name, storedRoom.Description, storedRoom.Password
+
+	event := &Event{
+		Type:      EventTypeRoomUpdated,
+		Timestamp: time.Now(),
+		Room:      crs.newRoomInfo(room),
+	}
+
+	laggards := crs.deliverToUsers(room, nil, event)
+
+	crs.mu.Unlock()
+
+	crs.disconnectLaggards(room, laggards)
+}
+
+// unloadRoom removes the room deleted by another instance from the rooms of this instance.
+func (crs *RoomServiceImpl) unloadRoom(shortCode string) {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return
+	}
+
+	crs.closeRoom(room)
+}
+
+// enqueue adds the event to the user's message queue without blocking.
+// If the queue is full, the room's slow consumer policy is applied. It reports whether the user keeps up with messages.
+// It should be called with the crs.mu read-write mutex and the room's deliver mutex locked, or with the crs.mu read-write mutex locked for writing.
+func (crs *RoomServiceImpl) enqueue(room *room, user *user, event *Event) bool {
+	select {
+	case user.messageQueue <- event:
+		return true
+	default:
+	}
+
+	switch room.settings.SlowConsumerPolicy {
+	case SlowConsumerPolicyDisconnect:
+		return false
+	case SlowConsumerPolicyDropNewest:
+		user.missed.Add(1)
+		crs.droppedMessages.Add(1)
+	default:
+		// Holding the deliver mutex makes this the only writer, so the queue has room once an event is taken out.
+		select {
+		case <-user.messageQueue:
+			user.missed.Add(1)
+			crs.droppedMessages.Add(1)
+		default:
+		}
+		select {
+		case user.messageQueue <- event:
+		default:
+			user.missed.Add(1)
+			crs.droppedMessages.Add(1)
+		}
+	}
+
+	return true
+}
+
+// deliverToUsers adds the event to the message queues of the users in the room accepted by the filter, or of all users in the room if the filter is nil.
+// It returns the users whose message queues were full under the room's slow consumer policy; they should be passed to disconnectLaggards after unlocking the crs.mu read-write mutex.
+// It should be called with the crs.mu read-write mutex locked for writing, or read-locked together with the room's deliver mutex, so that it is the only writer of the message queues.
+func (crs *RoomServiceImpl) deliverToUsers(room *room, filter func(userName string) bool, event *Event) []*user {
+	laggards := []*user{}
+	for _, user := range room.users {
+		if filter != nil && !filter(user.name) {
+			continue
+		}
+		if !crs.enqueue(room, user, event) {
+			laggards = append(laggards, user)
+		}
+	}
+
+	return laggards
+}
+
+// disconnectLaggards removes users, whose message queues were full, from the room.
+// It should be called without the crs.mu read-write mutex locked.
+func (crs *RoomServiceImpl) disconnectLaggards(room *room, laggards []*user) {
+	if len(laggards) == 0 {
+		return
+	}
+
+	crs.mu.Lock()
+
+	disconnected := []string{}
+	for _, laggard := range laggards {
+		// The user might have left the room in the meantime.
+		if room.users[laggard.name] != laggard {
+			continue
+		}
+
+		room.removeUser(laggard, ErrSlowConsumer)
+		room.evicted[laggard.name] = laggard
+		crs.disconnectedUsers.Add(1)
+		disconnected = append(disconnected, laggard.name)
+	}
+
+	crs.mu.Unlock()
+
+	for _, userName := range disconnected {
+		crs.publishMembership(broker.EventTypeUserLeft, room.shortCode, userName)
+	}
+}
+
+func (crs *RoomServiceImpl) SetUserTyping(shortCode string, userName string, typing bool) error {
+	changed, err := crs.setUserTyping(shortCode, userName, typing)
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		crs.publishTyping(shortCode, userName, typing)
+	}
+
+	return nil
+}
+
+// setUserTyping starts or stops the timer stopping the user's typing. It reports whether the user's typing has changed.
+func (crs *RoomServiceImpl) setUserTyping(shortCode string, userName string, typing bool) (bool, error) {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return false, ErrRoomDoesNotExist
+	}
+
+	if _, ok := room.users[userName]; !ok {
+		return false, ErrUserNotFound
+	}
+
+	timer, wasTyping := room.typing[userName]
+	if wasTyping {
+		timer.Stop()
+		delete(room.typing, userName)
+	}
+
+	if typing {
+		var newTimer *time.Timer
+		newTimer = time.AfterFunc(crs.typingTimeout, func() {
+			crs.mu.Lock()
+			// The typing might have been stopped or restarted in the meantime.
+			expired := room.typing[userName] == newTimer
+			if expired {
+				delete(room.typing, userName)
+			}
+			crs.mu.Unlock()
+
+			if expired {
+				crs.publishTyping(room.shortCode, userName, false)
+			}
+		})
+		room.typing[userName] = newTimer
+	}
+
+	return typing != wasTyping, nil
+}
+
+// publishTyping notifies all instances that the user has started or stopped typing.
+// The notification is ephemeral, so a failure is logged rather than returned.
+func (crs *RoomServiceImpl) publishTyping(shortCode, userName string, typing bool) {
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeTyping,
+		Instance:  crs.instance,
+		ShortCode: shortCode,
+		UserName:  userName,
+		Typing:    typing,
+	}); err != nil {
+		logger.Error(fmt.Sprintf("Failed to publish typing of user [%s] in chat room with short code [%s]: %s", userName, shortCode, err))
+	}
+}
+
+func (crs *RoomServiceImpl) MarkMessagesRead(shortCode string, userName string, sequence uint64) error {
+	crs.mu.RLock()
+	room, ok := crs.rooms[shortCode]
+	crs.mu.RUnlock()
+	if !ok {
+		return ErrRoomDoesNotExist
+	}
+
+	if sequence > room.lastSequence.Load() {
+		return ErrInvalidReadSequence
+	}
+
+	updated, err := crs.readCursorRepository.UpdateReadCursor(context.Background(), &model.ReadCursor{
+		ShortCode:        shortCode,
+		UserName:         userName,
+		LastReadSequence: sequence,
+		UpdatedAt:        time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store read cursor: %w", err)
+	}
+
+	if !updated {
+		return nil
+	}
+
+	if err := crs.broker.Publish(context.Background(), &broker.Event{
+		Type:      broker.EventTypeMessagesRead,
+		ShortCode: shortCode,
+		UserName:  userName,
+		Sequence:  sequence,
+	}); err != nil {
+		return fmt.Errorf("failed to publish read receipt: %w", err)
+	}
+
+	return nil
+}
+
+func (crs *RoomServiceImpl) GetUnreadCounts(userName string) ([]*UnreadCount, error) {
+	cursors, err := crs.readCursorRepository.GetReadCursors(context.Background(), userName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read cursors: %w", err)
+	}
+
+	unreadCounts := []*UnreadCount{}
+	for _, cursor := range cursors {
+		crs.mu.RLock()
+		room, ok := crs.rooms[cursor.ShortCode]
+		crs.mu.RUnlock()
+		// The cursor might outlive its room for a moment, until the deletion reaches this instance.
+		if !ok {
+			continue
+		}
+
+		count, err := crs.messageRepository.CountUnreadMessages(context.Background(), cursor.ShortCode, userName, cursor.LastReadSequence)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count unread messages: %w", err)
+		}
+
+		unreadCounts = append(unreadCounts, &UnreadCount{
+			ShortCode:        cursor.ShortCode,
+			RoomName:         room.name,
+			LastReadSequence: cursor.LastReadSequence,
+			Count:            count,
+		})
+	}
+
+	sort.Slice(unreadCounts, func(i, j int) bool {
+		return unreadCounts[i].ShortCode < unreadCounts[j].ShortCode
+	})
+
+	return unreadCounts, nil
+}
+
+func (crs *RoomServiceImpl) GetUserEvent(shortCode string, userName string) (*Event, error) {
+	crs.mu.RLock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		// Users of a deleted room still receive the queued events followed by the room deletion.
+		if room, ok = crs.deletedRooms[shortCode]; !ok {
+			crs.mu.RUnlock()
+			return nil, ErrRoomDoesNotExist
+		}
+	}
+
+	user, ok := room.users[userName]
+	if !ok {
+		// An evicted user still receives the queued events followed by the reason of the removal.
+		if user, ok = room.evicted[userName]; !ok {
+			crs.mu.RUnlock()
+			return nil, ErrUserNotFound
+		}
+	}
+
+	crs.mu.RUnlock()
+
+	// Dropped events are reported once the user has caught up with the queued ones.
+	if len(user.messageQueue) == 0 {
+		if missed := user.missed.Swap(0); missed > 0 {
+			return &Event{
+				Type:           EventTypeMissedMessages,
+				Timestamp:      time.Now(),
+				MissedMessages: missed,
+			}, nil
+		}
+	}
+
+	event, ok := <-user.messageQueue
+	if !ok {
+		crs.forgetEvictedUser(room, user)
+
+		if user.err != nil {
+			return nil, user.err
+		}
+		return nil, ErrUserMessageQueueClosed
+	}
+
+	return event, nil
+}
+
+// forgetEvictedUser removes the evicted user, who has received all queued events, from the room.
+// The deleted room is forgotten once none of its users is left.
+func (crs *RoomServiceImpl) forgetEvictedUser(room *room, user *user) {
+	crs.mu.Lock()
+	defer crs.mu.Unlock()
+
+	if room.evicted[user.name] == user {
+		delete(room.evicted, user.name)
+	}
+
+	if len(room.evicted) == 0 && crs.deletedRooms[room.shortCode] == room {
+		delete(crs.deletedRooms, room.shortCode)
+	}
+}
+
+func (crs *RoomServiceImpl) GetSlowConsumerMetrics() SlowConsumerMetrics {
+	return SlowConsumerMetrics{
+		DroppedMessages:   crs.droppedMessages.Load(),
+		DisconnectedUsers: crs.disconnectedUsers.Load(),
+	}
+}
+
+func (crs *RoomServiceImpl) GetChatHistory(shortCode, userName string, cursor, limit int) ([]*model.Message, error) {
+	if !crs.RoomExists(shortCode) {
+		return nil, ErrRoomDoesNotExist
+	}
+
+	messages, err := crs.messageRepository.GetMessages(context.Background(), shortCode, userName, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	crs.mu.RLock()
+	defer crs.mu.RUnlock()
+
+	room, ok := crs.rooms[shortCode]
+	if !ok {
+		return nil, ErrRoomDoesNotExist
+	}
+
+	history := make([]*model.Message, 0, len(messages))
+	for _, message := range messages {
+		historyMessage := *message
+		for emoji, users := range room.reactions[message.MessageID] {
+			if historyMessage.Reactions == nil {
+				historyMessage.Reactions = make(map[string]int)
+			}
+			historyMessage.Reactions[emoji] = len(users)
+		}
+		history = append(history, &historyMessage)
+	}
+
+	return history, nil
+}
+
+// removeUser closes the user's message queue and removes them from the room.
+// The err is returned to the user once they have received all queued messages.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) removeUser(user *user, err error) {
+	user.err = err
+	close(user.messageQueue)
+	delete(r.users, user.name)
+
+	if timer, ok := r.typing[user.name]; ok {
+		timer.Stop()
+		delete(r.typing, user.name)
+	}
+}
+
+// role returns the role of the user in the room.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) role(userName string) RoomRole {
+	if userName == r.owner {
+		return RoomRoleOwner
+	}
+	if role, ok := r.roles[userName]; ok {
+		return role
+	}
+	return RoomRoleMember
+}
+
+// checkRole checks if the user has at least the given role in the room.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) checkRole(userName string, minRole RoomRole) error {
+	if r.role(userName) >= minRole {
+		return nil
+	}
+	if minRole == RoomRoleOwner {
+		return ErrNotOwner
+	}
+	return ErrInsufficientRole
+}
+
+// longestPresentUser returns the name of the user present in the room for the longest time who may own it, i.e. is neither its owner, banned nor read-only.
+// It returns an empty string if there is no such user.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) longestPresentUser() string {
+	var (
+		successor string
+		since     time.Time
+	)
+	for userName, joinedAt := range r.present {
+		if _, banned := r.banned[userName]; banned || userName == r.owner || r.role(userName) < RoomRoleMember {
+			continue
+		}
+		if successor == "" || joinedAt.Before(since) || joinedAt.Equal(since) && userName < successor {
+			successor, since = userName, joinedAt
+		}
+	}
+	return successor
+}
+
+// stopDeletionTimer stops the scheduled deletion of the room left by its owner, if any.
+// It should be called with the RoomServiceImpl's mutex locked for writing.
+func (r *room) stopDeletionTimer() {
+	if r.deletionTimer != nil {
+		r.deletionTimer.Stop()
+		r.deletionTimer = nil
+	}
+}
+
+// checkAccess checks if the user is neither banned nor kicked from the room.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) checkAccess(userName string) error {
+	if _, ok := r.banned[userName]; ok {
+		return ErrUserBanned
+	}
+	if _, ok := r.kicked[userName]; ok {
+		return ErrUserKicked
+	}
+	return nil
+}
+
+// closeRoom removes the deleted room from the rooms of this instance.
+// Its users receive the room deletion after all queued events and are kept until they have received it.
+// It should be called with the crs.mu read-write mutex locked for writing.
+func (crs *RoomServiceImpl) closeRoom(room *room) {
+	event := &Event{
+		Type:      EventTypeRoomDeleted,
+		Timestamp: time.Now(),
+	}
+
+	for _, user := range room.users {
+		// The write lock keeps out other writers, but not the user's consumer, which may drain the queue at any time, so neither operation may block.
+		var err error
+		select {
+		case user.messageQueue <- event:
+		default:
+			select {
+			case <-user.messageQueue:
+				crs.droppedMessages.Add(1)
+			default:
+			}
+			select {
+			case user.messageQueue <- event:
+			default:
+				// The queue has no room for the deletion, e.g. because it is unbuffered, so the user learns about it from the error instead.
+				err = ErrRoomDoesNotExist
+			}
+		}
+
+		room.removeUser(user, err)
+		room.evicted[user.name] = user
+	}
+
+	room.stopDeletionTimer()
+
+	delete(crs.rooms, room.shortCode)
+	if len(room.evicted) > 0 {
+		crs.deletedRooms[room.shortCode] = room
+	}
+}
+
+// addReaction records the user's reaction to the message. It reports whether the user has not reacted with the emoji before.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) addReaction(messageID, emoji, userName string) bool {
+	if _, ok := r.reactions[messageID]; !ok {
+		r.reactions[messageID] = make(map[string]map[string]struct{})
+	}
+	if _, ok := r.reactions[messageID][emoji]; !ok {
+		r.reactions[messageID][emoji] = make(map[string]struct{})
+	}
+
+	if _, ok := r.reactions[messageID][emoji][userName]; ok {
+		return false
+	}
+	r.reactions[messageID][emoji][userName] = struct{}{}
+
+	return true
+}
+
+// removeReaction withdraws the user's reaction to the message. It reports whether the user has reacted with the emoji before.
+// It should be called with the RoomServiceImpl's mutex locked.
+func (r *room) removeReaction(messageID, emoji, userName string) bool {
+	if _, ok := r.reactions[messageID][emoji][userName]; !ok {
+		return false
+	}
+
+	delete(r.reactions[messageID][emoji], userName)
+	if len(r.reactions[messageID][emoji]) == 0 {
+		delete(r.reactions[messageID], emoji)
+	}
+	if len(r.reactions[messageID]) == 0 {
+		delete(r.reactions, messageID)
 	}
 
-	return msg, nil
+	return true
 }
@@ -0,0 +1,1177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/broker"
+	"github.com/MSSkowron/GRPCChatter/internal/model"
+	"github.com/MSSkowron/GRPCChatter/internal/repository"
+	"github.com/MSSkowron/GRPCChatter/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testShortCode    = "ABC123"
+	testRoomName     = "room"
+	testRoomPassword = "password"
+	testOwner        = "owner1"
+)
+
+func newTestRoomService(t *testing.T, maxMessageQueueSize, replayBufferSize int) *RoomServiceImpl {
+	crs, err := NewRoomService(maxMessageQueueSize, replayBufferSize, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	return crs
+}
+
+func TestBroadcastMessageToRoomAssignsSequence(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "sender1"))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "receiver1"))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "hello"}))
+	}
+
+	ids := map[string]struct{}{}
+	for i := uint64(1); i <= 3; i++ {
+		msg := getUserMessage(t, crs, "receiver1")
+		require.Equal(t, i, msg.Sequence)
+		require.NotEmpty(t, msg.ID)
+		require.False(t, msg.Timestamp.IsZero())
+		ids[msg.ID] = struct{}{}
+	}
+	require.Len(t, ids, 3)
+
+	history, err := crs.GetChatHistory(testShortCode, "receiver1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(3), history[0].Sequence)
+}
+
+func TestBroadcastMessageToRoomStoresMessage(t *testing.T) {
+	messageRepository := repository.NewMockMessageRepository()
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), messageRepository, repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+
+	// The message is stored even though nobody else is in the room to receive it.
+	message := &Message{Sender: testOwner, Body: "hello"}
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, message))
+
+	require.Len(t, messageRepository.Messages, 1)
+	for _, stored := range messageRepository.Messages {
+		require.Equal(t, message.ID, stored.MessageID)
+		require.Equal(t, testShortCode, stored.ShortCode)
+		require.Equal(t, testOwner, stored.Sender)
+		require.Equal(t, "hello", stored.Body)
+		require.Equal(t, message.Sequence, stored.Sequence)
+		require.True(t, message.Timestamp.Equal(stored.CreatedAt))
+	}
+
+	// A user joining later can read it from the history.
+	history, err := crs.GetChatHistory(testShortCode, "user1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, message.ID, history[0].MessageID)
+}
+
+func TestGetChatHistoryPaging(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: fmt.Sprintf("message %d", i)}))
+	}
+
+	// Pages go backwards from the newest message, each starting before the last message of the previous one.
+	sequences := []uint64{}
+	cursor := 0
+	for {
+		history, err := crs.GetChatHistory(testShortCode, testOwner, cursor, 2)
+		require.NoError(t, err)
+		if len(history) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(history), 2)
+
+		for _, message := range history {
+			sequences = append(sequences, message.Sequence)
+		}
+		cursor = history[len(history)-1].ID
+	}
+	require.Equal(t, []uint64{5, 4, 3, 2, 1}, sequences)
+
+	_, err := crs.GetChatHistory("XYZ789", testOwner, 0, 2)
+	require.ErrorIs(t, err, ErrRoomDoesNotExist)
+}
+
+func TestBroadcastMessageToRoomSequencesPerRoom(t *testing.T) {
+	const messagesPerSender = 10
+
+	crs := newTestRoomService(t, 100, 10)
+	require.NoError(t, crs.CreateRoom("XYZ789", testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "receiver1"))
+	require.NoError(t, crs.AddUserToRoom("XYZ789", "receiver1"))
+
+	// Messages sent concurrently to both rooms are delivered in the order of their sequence numbers, which are counted separately in each room.
+	senders := []string{"sender1", "sender2", "sender3"}
+	var wg sync.WaitGroup
+	for _, shortCode := range []string{testShortCode, "XYZ789"} {
+		for _, sender := range senders {
+			wg.Add(1)
+			go func(shortCode, sender string) {
+				defer wg.Done()
+				for i := 0; i < messagesPerSender; i++ {
+					require.NoError(t, crs.BroadcastMessageToRoom(shortCode, &Message{Sender: sender, Body: "hello"}))
+				}
+			}(shortCode, sender)
+		}
+	}
+	wg.Wait()
+
+	for _, shortCode := range []string{testShortCode, "XYZ789"} {
+		for expected := uint64(1); expected <= uint64(len(senders)*messagesPerSender); expected++ {
+			event, err := crs.GetUserEvent(shortCode, "receiver1")
+			require.NoError(t, err)
+			require.Equal(t, EventTypeMessage, event.Type)
+			require.Equal(t, expected, event.Message.Sequence)
+		}
+	}
+}
+
+func TestBroadcastMessageToRoomTooLarge(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "sender1"))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "receiver1"))
+
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: strings.Repeat("x", MaxMessageBodySize+1)}), ErrMessageTooLarge)
+
+	// The rejected message is not stored, so it takes no sequence number.
+	history, err := crs.GetChatHistory(testShortCode, "sender1", 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: strings.Repeat("x", MaxMessageBodySize)}))
+	msg := getUserMessage(t, crs, "receiver1")
+	require.Equal(t, uint64(1), msg.Sequence)
+
+	require.ErrorIs(t, crs.EditMessage(testShortCode, "sender1", msg.ID, strings.Repeat("x", MaxMessageBodySize+1)), ErrMessageTooLarge)
+	require.ErrorIs(t, crs.BroadcastAnnouncement("admin", strings.Repeat("x", MaxMessageBodySize+1)), ErrMessageTooLarge)
+}
+
+// deletingMessageRepository deletes the room before each message is stored, as if it was deleted concurrently.
+// Like the database, it does not store messages to deleted rooms.
+type deletingMessageRepository struct {
+	*repository.MockMessageRepository
+	deleteRoom func()
+}
+
+func (dmr *deletingMessageRepository) AddMessage(ctx context.Context, message *model.Message) (*model.Message, error) {
+	dmr.deleteRoom()
+	return nil, nil
+}
+
+func TestBroadcastMessageToRoomDeletedConcurrently(t *testing.T) {
+	messageRepository := &deletingMessageRepository{MockMessageRepository: repository.NewMockMessageRepository()}
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), messageRepository, repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "sender1"))
+
+	messageRepository.deleteRoom = func() {
+		require.NoError(t, crs.DeleteRoom(testShortCode, testOwner))
+	}
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "hello"}), ErrRoomDoesNotExist)
+	require.Empty(t, messageRepository.Messages)
+
+	// Once the room is gone, messages are rejected before they are stored.
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "hello"}), ErrRoomDoesNotExist)
+}
+
+func TestResumeUserInRoom(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "sender1"))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "receiver1"))
+
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "1"}))
+	require.Equal(t, uint64(1), getUserMessage(t, crs, "receiver1").Sequence)
+
+	// The user is still in the room
+	require.ErrorIs(t, crs.ResumeUserInRoom(testShortCode, "receiver1", 1), ErrUserAlreadyExists)
+
+	require.NoError(t, crs.RemoveUserFromRoom(testShortCode, "receiver1"))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "2"}))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "receiver1", Body: "3"}))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "4"}))
+
+	require.NoError(t, crs.ResumeUserInRoom(testShortCode, "receiver1", 1))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "5"}))
+
+	// Own messages are not replayed
+	for _, expected := range []uint64{2, 4, 5} {
+		msg := getUserMessage(t, crs, "receiver1")
+		require.Equal(t, expected, msg.Sequence)
+	}
+
+	require.ErrorIs(t, crs.ResumeUserInRoom("invalid", "receiver1", 0), ErrRoomDoesNotExist)
+}
+
+func TestNewRoomServiceLoadsRooms(t *testing.T) {
+	roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository := repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository()
+
+	crs, err := NewRoomService(10, 2, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{SlowConsumerPolicy: SlowConsumerPolicyDropNewest}))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: "hello"}))
+	}
+
+	restarted, err := NewRoomService(10, 2, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	require.True(t, restarted.RoomExists(testShortCode))
+	require.Equal(t, SlowConsumerPolicyDropNewest, restarted.rooms[testShortCode].settings.SlowConsumerPolicy)
+	require.NoError(t, restarted.CheckPassword(testShortCode, testRoomPassword))
+
+	require.NoError(t, restarted.ResumeUserInRoom(testShortCode, "receiver1", 0))
+	require.NoError(t, restarted.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: "hello"}))
+	for _, expected := range []uint64{2, 3, 4} {
+		msg := getUserMessage(t, restarted, "receiver1")
+		require.Equal(t, expected, msg.Sequence)
+	}
+
+	require.NoError(t, restarted.DeleteRoom(testShortCode, testOwner))
+	require.Empty(t, roomRepository.Rooms)
+}
+
+func TestNewRoomServiceLoadsStoredRoom(t *testing.T) {
+	passwordHash, err := crypto.HashPassword(testRoomPassword)
+	require.NoError(t, err)
+	createdAt := time.Now().Add(-time.Hour).Round(time.Second)
+
+	// The room has been stored before the restart.
+	roomRepository := repository.NewMockRoomRepository()
+	_, err = roomRepository.AddRoom(context.Background(), &model.Room{
+		ShortCode:          testShortCode,
+		CreatedAt:          createdAt,
+		Name:               testRoomName,
+		Password:           passwordHash,
+		Owner:              testOwner,
+		SlowConsumerPolicy: SlowConsumerPolicyDefault.String(),
+	})
+	require.NoError(t, err)
+
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+	require.NoError(t, err)
+
+	info, err := crs.GetRoomInfo(testShortCode)
+	require.NoError(t, err)
+	require.Equal(t, testRoomName, info.Name)
+	require.Equal(t, testOwner, info.Owner)
+	require.True(t, createdAt.Equal(info.CreatedAt))
+
+	// The password is checked against the stored hash.
+	require.NoError(t, crs.CheckPassword(testShortCode, testRoomPassword))
+	require.Error(t, crs.CheckPassword(testShortCode, "wrongpassword"))
+
+	// Creating a room with the short code of the loaded one fails.
+	require.ErrorIs(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}), ErrRoomAlreadyExist)
+}
+
+func TestRoomServiceMultipleInstances(t *testing.T) {
+	eventBroker := broker.NewInProcessBroker()
+	defer eventBroker.Close()
+
+	roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository := repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository()
+
+	first, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, eventBroker, roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	second, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, eventBroker, roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+
+	require.NoError(t, first.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	require.Eventually(t, func() bool { return second.RoomExists(testShortCode) }, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, first.AddUserToRoom(testShortCode, "user1"))
+	require.NoError(t, second.AddUserToRoom(testShortCode, "user2"))
+	require.Eventually(t, func() bool {
+		users, err := first.GetRoomUsers(testShortCode)
+		return err == nil && len(users) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, first.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user1", Body: "1"}))
+	require.NoError(t, second.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user2", Body: "2"}))
+
+	msg := getUserMessage(t, second, "user2")
+	require.Equal(t, "1", msg.Body)
+	require.Equal(t, uint64(1), msg.Sequence)
+
+	msg = getUserMessage(t, first, "user1")
+	require.Equal(t, "2", msg.Body)
+	require.Equal(t, uint64(2), msg.Sequence)
+
+	require.NoError(t, first.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user1", Body: "private", Recipient: "user2"}))
+	msg = getUserMessage(t, second, "user2")
+	require.Equal(t, "private", msg.Body)
+	require.Equal(t, "user2", msg.Recipient)
+
+	require.NoError(t, second.RemoveUserFromRoom(testShortCode, "user2"))
+	event := getUserEvent(t, first, "user1")
+	require.Equal(t, EventTypeUserLeft, event.Type)
+	require.Equal(t, "user2", event.UserName)
+
+	require.NoError(t, second.AddUserToRoom(testShortCode, "user2"))
+	event = getUserEvent(t, first, "user1")
+	require.Equal(t, EventTypeUserJoined, event.Type)
+	require.Equal(t, "user2", event.UserName)
+
+	require.NoError(t, first.DeleteRoom(testShortCode, testOwner))
+	require.Equal(t, EventTypeRoomDeleted, getUserEvent(t, second, "user2").Type)
+	_, err = second.GetUserEvent(testShortCode, "user2")
+	require.ErrorIs(t, err, ErrUserMessageQueueClosed)
+	require.False(t, second.RoomExists(testShortCode))
+}
+
+func TestBroadcastPrivateMessage(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, userName := range []string{"sender1", "receiver1", "receiver2"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "1", Recipient: "invalid"}), ErrRecipientNotFound)
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "1", Recipient: "sender1"}), ErrInvalidRecipient)
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "private", Recipient: "receiver1"}))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "public"}))
+
+	msg := getUserMessage(t, crs, "receiver1")
+	require.Equal(t, "private", msg.Body)
+	require.Equal(t, "receiver1", msg.Recipient)
+
+	msg = getUserMessage(t, crs, "receiver2")
+	require.Equal(t, "public", msg.Body)
+
+	history, err := crs.GetChatHistory(testShortCode, "receiver2", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+
+	history, err = crs.GetChatHistory(testShortCode, "receiver1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	// Private messages are replayed only to their recipient
+	require.NoError(t, crs.RemoveUserFromRoom(testShortCode, "receiver2"))
+	require.NoError(t, crs.ResumeUserInRoom(testShortCode, "receiver2", 0))
+	msg = getUserMessage(t, crs, "receiver2")
+	require.Equal(t, "public", msg.Body)
+}
+
+func TestEditAndDeleteMessage(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, userName := range []string{testOwner, "sender1", "receiver1"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "hello"}))
+	messageID := getUserMessage(t, crs, "receiver1").ID
+	getUserMessage(t, crs, testOwner)
+
+	require.ErrorIs(t, crs.EditMessage(testShortCode, "receiver1", messageID, "edited"), ErrNotMessageSender)
+	require.ErrorIs(t, crs.EditMessage(testShortCode, "sender1", "invalid", "edited"), ErrMessageNotFound)
+	require.ErrorIs(t, crs.DeleteMessage(testShortCode, "sender1", uuid.New().String()), ErrMessageNotFound)
+	require.ErrorIs(t, crs.DeleteMessage("invalid", "sender1", messageID), ErrRoomDoesNotExist)
+
+	require.NoError(t, crs.EditMessage(testShortCode, "sender1", messageID, "edited"))
+	for _, userName := range []string{"sender1", "receiver1"} {
+		event := getUserEvent(t, crs, userName)
+		for event.Type == EventTypeUserJoined {
+			event = getUserEvent(t, crs, userName)
+		}
+		require.Equal(t, EventTypeMessageEdited, event.Type)
+		require.Equal(t, messageID, event.Message.ID)
+		require.Equal(t, "edited", event.Message.Body)
+		require.False(t, event.Message.EditedAt.IsZero())
+	}
+
+	history, err := crs.GetChatHistory(testShortCode, "receiver1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "edited", history[0].Body)
+	require.NotNil(t, history[0].EditedAt)
+
+	// The owner can delete messages of other users
+	require.NoError(t, crs.DeleteMessage(testShortCode, testOwner, messageID))
+	event := getUserEvent(t, crs, "receiver1")
+	require.Equal(t, EventTypeMessageDeleted, event.Type)
+	require.Equal(t, messageID, event.Message.ID)
+
+	history, err = crs.GetChatHistory(testShortCode, "receiver1", 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// Deleted messages are not replayed
+	require.NoError(t, crs.RemoveUserFromRoom(testShortCode, "receiver1"))
+	require.NoError(t, crs.ResumeUserInRoom(testShortCode, "receiver1", 0))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "private", Recipient: "receiver1"}))
+	message := getUserMessage(t, crs, "receiver1")
+	require.Equal(t, uint64(2), message.Sequence)
+
+	// Private messages of other users cannot be modified even by the owner
+	require.ErrorIs(t, crs.DeleteMessage(testShortCode, testOwner, message.ID), ErrMessageNotFound)
+}
+
+func TestRepliesAndReactions(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, userName := range []string{"sender1", "receiver1"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "sender1", Body: "hello"}))
+	messageID := getUserMessage(t, crs, "receiver1").ID
+
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "receiver1", Body: "reply", ReplyTo: uuid.New().String()}), ErrMessageNotFound)
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "receiver1", Body: "reply", ReplyTo: messageID}))
+	require.Equal(t, messageID, getUserMessage(t, crs, "sender1").ReplyTo)
+
+	require.ErrorIs(t, crs.AddReaction(testShortCode, "receiver1", messageID, ""), ErrInvalidReaction)
+	require.ErrorIs(t, crs.AddReaction(testShortCode, "receiver1", "invalid", "+1"), ErrMessageNotFound)
+
+	for _, userName := range []string{"sender1", "receiver1"} {
+		require.NoError(t, crs.AddReaction(testShortCode, userName, messageID, "+1"))
+	}
+	// Reacting again with the same emoji changes nothing
+	require.NoError(t, crs.AddReaction(testShortCode, "receiver1", messageID, "+1"))
+	require.NoError(t, crs.RemoveReaction(testShortCode, "sender1", messageID, "+1"))
+
+	for _, userName := range []string{"sender1", "receiver1"} {
+		for _, expected := range []Reaction{
+			{MessageID: messageID, Emoji: "+1", UserName: "sender1", Added: true, Count: 1},
+			{MessageID: messageID, Emoji: "+1", UserName: "receiver1", Added: true, Count: 2},
+			{MessageID: messageID, Emoji: "+1", UserName: "sender1", Added: false, Count: 1},
+		} {
+			event := getUserEvent(t, crs, userName)
+			require.Equal(t, EventTypeReactionChanged, event.Type)
+			require.Equal(t, expected, *event.Reaction)
+		}
+	}
+
+	history, err := crs.GetChatHistory(testShortCode, "sender1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, messageID, history[0].ReplyTo)
+	require.Equal(t, map[string]int{"+1": 1}, history[1].Reactions)
+
+	// Reactions to deleted messages are forgotten
+	require.NoError(t, crs.DeleteMessage(testShortCode, "sender1", messageID))
+	require.Equal(t, EventTypeMessageDeleted, getUserEvent(t, crs, "receiver1").Type)
+	require.ErrorIs(t, crs.RemoveReaction(testShortCode, "receiver1", messageID, "+1"), ErrMessageNotFound)
+	history, err = crs.GetChatHistory(testShortCode, "sender1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Empty(t, history[0].Reactions)
+}
+
+func TestReadReceiptsAndUnreadCounts(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, userName := range []string{testOwner, "sender1"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: "before"}))
+	getUserMessage(t, crs, "sender1")
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "reader1"))
+
+	// Messages sent before joining are considered read
+	unreadCounts, err := crs.GetUnreadCounts("reader1")
+	require.NoError(t, err)
+	require.Equal(t, []*UnreadCount{{ShortCode: testShortCode, RoomName: testRoomName, LastReadSequence: 1, Count: 0}}, unreadCounts)
+
+	for _, message := range []*Message{
+		{Sender: "sender1", Body: "first"},
+		{Sender: "sender1", Body: "second"},
+		{Sender: "sender1", Body: "private", Recipient: testOwner},
+		{Sender: "reader1", Body: "own"},
+	} {
+		require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, message))
+	}
+	for range []string{"first", "second"} {
+		getUserMessage(t, crs, "reader1")
+	}
+
+	unreadCounts, err = crs.GetUnreadCounts("reader1")
+	require.NoError(t, err)
+	require.Len(t, unreadCounts, 1)
+	require.Equal(t, 2, unreadCounts[0].Count)
+
+	require.ErrorIs(t, crs.MarkMessagesRead(testShortCode, "reader1", 6), ErrInvalidReadSequence)
+	require.ErrorIs(t, crs.MarkMessagesRead("invalid", "reader1", 1), ErrRoomDoesNotExist)
+	require.NoError(t, crs.MarkMessagesRead(testShortCode, "reader1", 2))
+	// Moving the cursor backwards changes nothing
+	require.NoError(t, crs.MarkMessagesRead(testShortCode, "reader1", 1))
+	require.NoError(t, crs.MarkMessagesRead(testShortCode, "reader1", 3))
+
+	for _, expected := range []uint64{2, 3} {
+		event := getUserEvent(t, crs, "sender1")
+		for event.Type != EventTypeReadReceipt {
+			event = getUserEvent(t, crs, "sender1")
+		}
+		require.Equal(t, "reader1", event.UserName)
+		require.Equal(t, expected, event.ReadSequence)
+	}
+
+	unreadCounts, err = crs.GetUnreadCounts("reader1")
+	require.NoError(t, err)
+	require.Equal(t, []*UnreadCount{{ShortCode: testShortCode, RoomName: testRoomName, LastReadSequence: 3, Count: 0}}, unreadCounts)
+
+	unreadCounts, err = crs.GetUnreadCounts("stranger1")
+	require.NoError(t, err)
+	require.Empty(t, unreadCounts)
+}
+
+func TestPresenceEvents(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user2"))
+
+	event := getUserEvent(t, crs, "user1")
+	require.Equal(t, EventTypeUserJoined, event.Type)
+	require.Equal(t, "user2", event.UserName)
+	require.False(t, event.Timestamp.IsZero())
+
+	require.NoError(t, crs.RemoveUserFromRoom(testShortCode, "user2"))
+	event = getUserEvent(t, crs, "user1")
+	require.Equal(t, EventTypeUserLeft, event.Type)
+	require.Equal(t, "user2", event.UserName)
+
+	require.ErrorIs(t, crs.DeleteRoom(testShortCode, "user1"), ErrNotOwner)
+	require.NoError(t, crs.DeleteRoom(testShortCode, testOwner))
+	require.Equal(t, EventTypeRoomDeleted, getUserEvent(t, crs, "user1").Type)
+	_, err := crs.GetUserEvent(testShortCode, "user1")
+	require.ErrorIs(t, err, ErrUserMessageQueueClosed)
+
+	// The deleted room is forgotten once its users have received the deletion.
+	_, err = crs.GetUserEvent(testShortCode, "user1")
+	require.ErrorIs(t, err, ErrRoomDoesNotExist)
+	require.Empty(t, crs.deletedRooms)
+}
+
+func TestSetUserTyping(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	crs.typingTimeout = 50 * time.Millisecond
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user2"))
+	require.Equal(t, EventTypeUserJoined, getUserEvent(t, crs, "user1").Type)
+
+	require.ErrorIs(t, crs.SetUserTyping(testShortCode, "invalid", true), ErrUserNotFound)
+	require.ErrorIs(t, crs.SetUserTyping("invalid", "user2", true), ErrRoomDoesNotExist)
+
+	// Typing started again only postpones its expiry.
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", true))
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", true))
+	for _, typing := range []bool{true, false} {
+		event := getUserEvent(t, crs, "user1")
+		require.Equal(t, EventTypeTyping, event.Type)
+		require.Equal(t, "user2", event.UserName)
+		require.Equal(t, typing, event.Typing)
+	}
+
+	crs.typingTimeout = time.Minute
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", true))
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", false))
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", false))
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user2", Body: "hello"}))
+	require.True(t, getUserEvent(t, crs, "user1").Typing)
+	require.False(t, getUserEvent(t, crs, "user1").Typing)
+	require.Equal(t, EventTypeMessage, getUserEvent(t, crs, "user1").Type)
+
+	// Typing users leaving the room do not leave their timers behind.
+	require.NoError(t, crs.SetUserTyping(testShortCode, "user2", true))
+	require.NoError(t, crs.RemoveUserFromRoom(testShortCode, "user2"))
+	require.Empty(t, crs.rooms[testShortCode].typing)
+}
+
+func TestListPublicRooms(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, room := range []struct {
+		shortCode, name string
+		public          bool
+	}{
+		{"ROOM1", "Go Developers", true},
+		{"ROOM2", "gophers", true},
+		{"ROOM3", "Go secret", false},
+		{"ROOM4", "Rustaceans", true},
+	} {
+		require.NoError(t, crs.CreateRoom(room.shortCode, room.name, testRoomPassword, testOwner, RoomSettings{Public: room.public}))
+	}
+	require.NoError(t, crs.AddUserToRoom("ROOM2", "user1"))
+
+	rooms, err := crs.ListPublicRooms("GO", "", 10)
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+	require.Equal(t, "ROOM1", rooms[0].ShortCode)
+	require.Equal(t, "ROOM2", rooms[1].ShortCode)
+	require.Equal(t, "gophers", rooms[1].Name)
+	require.Equal(t, testOwner, rooms[1].Owner)
+	require.Equal(t, 1, rooms[1].MemberCount)
+	require.True(t, rooms[1].Settings.Public)
+
+	rooms, err = crs.ListPublicRooms("", "", 2)
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+	rooms, err = crs.ListPublicRooms("", rooms[1].ShortCode, 2)
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	require.Equal(t, "ROOM4", rooms[0].ShortCode)
+}
+
+func TestGetAndUpdateRoom(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+
+	name, description, password := "renamed", "new topic", "new password"
+	require.ErrorIs(t, crs.UpdateRoom(testShortCode, "user1", RoomUpdate{Name: &name}), ErrNotOwner)
+	require.ErrorIs(t, crs.UpdateRoom("invalid", testOwner, RoomUpdate{Name: &name}), ErrRoomDoesNotExist)
+	empty := " "
+	require.ErrorIs(t, crs.UpdateRoom(testShortCode, testOwner, RoomUpdate{Name: &empty}), ErrInvalidRoomName)
+
+	require.NoError(t, crs.UpdateRoom(testShortCode, testOwner, RoomUpdate{Name: &name, Description: &description, Password: &password}))
+	event := getUserEvent(t, crs, "user1")
+	require.Equal(t, EventTypeRoomUpdated, event.Type)
+	require.Equal(t, name, event.Room.Name)
+	require.Equal(t, description, event.Room.Description)
+
+	room, err := crs.GetRoomInfo(testShortCode)
+	require.NoError(t, err)
+	require.Equal(t, name, room.Name)
+	require.Equal(t, description, room.Description)
+	require.Equal(t, testOwner, room.Owner)
+	require.Equal(t, 1, room.MemberCount)
+	require.Equal(t, SlowConsumerPolicyDropOldest, room.Settings.SlowConsumerPolicy)
+
+	require.ErrorIs(t, crs.CheckPassword(testShortCode, testRoomPassword), ErrInvalidRoomPassword)
+	require.NoError(t, crs.CheckPassword(testShortCode, password))
+
+	// Only the given fields are changed
+	require.NoError(t, crs.UpdateRoom(testShortCode, testOwner, RoomUpdate{Description: &empty}))
+	require.Equal(t, name, getUserEvent(t, crs, "user1").Room.Name)
+
+	_, err = crs.GetRoomInfo("invalid")
+	require.ErrorIs(t, err, ErrRoomDoesNotExist)
+}
+
+func TestKickAndBanUser(t *testing.T) {
+	roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository := repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository()
+
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	for _, userName := range []string{"user1", "user2"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	require.ErrorIs(t, crs.KickUser(testShortCode, "user1", "user2"), ErrInsufficientRole)
+	require.ErrorIs(t, crs.KickUser(testShortCode, testOwner, testOwner), ErrCannotRemoveOwner)
+	require.ErrorIs(t, crs.KickUser(testShortCode, testOwner, "user3"), ErrUserNotFound)
+	require.ErrorIs(t, crs.KickUser("invalid", testOwner, "user2"), ErrRoomDoesNotExist)
+
+	require.NoError(t, crs.KickUser(testShortCode, testOwner, "user2"))
+	for _, userName := range []string{"user1", "user2"} {
+		event := getUserEvent(t, crs, userName)
+		for event.Type == EventTypeUserJoined {
+			event = getUserEvent(t, crs, userName)
+		}
+		require.Equal(t, EventTypeUserKicked, event.Type)
+		require.Equal(t, "user2", event.UserName)
+	}
+	_, err = crs.GetUserEvent(testShortCode, "user2")
+	require.ErrorIs(t, err, ErrUserKicked)
+	require.Equal(t, EventTypeUserLeft, getUserEvent(t, crs, "user1").Type)
+
+	// The chat token of a kicked user is invalid until they join the room again
+	require.ErrorIs(t, crs.CheckUserAccess(testShortCode, "user2"), ErrUserKicked)
+	require.ErrorIs(t, crs.ResumeUserInRoom(testShortCode, "user2", 0), ErrUserKicked)
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user2"))
+	require.NoError(t, crs.CheckUserAccess(testShortCode, "user2"))
+
+	require.NoError(t, crs.BanUser(testShortCode, testOwner, "user2"))
+	require.Equal(t, EventTypeUserBanned, getUserEvent(t, crs, "user2").Type)
+	_, err = crs.GetUserEvent(testShortCode, "user2")
+	require.ErrorIs(t, err, ErrUserBanned)
+	require.ErrorIs(t, crs.CheckUserAccess(testShortCode, "user2"), ErrUserBanned)
+	require.ErrorIs(t, crs.AddUserToRoom(testShortCode, "user2"), ErrUserBanned)
+
+	// Users can be banned before they join the room and bans survive restarts
+	require.NoError(t, crs.BanUser(testShortCode, testOwner, "user3"))
+	restarted, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	require.ErrorIs(t, restarted.AddUserToRoom(testShortCode, "user3"), ErrUserBanned)
+
+	require.ErrorIs(t, crs.UnbanUser(testShortCode, "user1", "user2"), ErrNotOwner)
+	require.ErrorIs(t, crs.UnbanUser(testShortCode, testOwner, "user1"), ErrUserNotBanned)
+	require.NoError(t, crs.UnbanUser(testShortCode, testOwner, "user2"))
+	require.Eventually(t, func() bool { return crs.AddUserToRoom(testShortCode, "user2") == nil }, time.Second, 10*time.Millisecond)
+}
+
+func TestUserRoles(t *testing.T) {
+	roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository := repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository()
+
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	for _, userName := range []string{"user1", "user2", "user3"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	role, err := crs.GetUserRole(testShortCode, testOwner)
+	require.NoError(t, err)
+	require.Equal(t, RoomRoleOwner, role)
+
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, "user1", "user2", RoomRoleModerator), ErrNotOwner)
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, "user1", "user2", RoomRoleReadOnly), ErrInsufficientRole)
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, testOwner, testOwner, RoomRoleMember), ErrOwnerRole)
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, testOwner, "user1", RoomRoleOwner), ErrInvalidRoomRole)
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, testOwner, "user4", RoomRoleModerator), ErrUserNotFound)
+	require.ErrorIs(t, crs.SetUserRole("invalid", testOwner, "user1", RoomRoleModerator), ErrRoomDoesNotExist)
+
+	require.NoError(t, crs.SetUserRole(testShortCode, testOwner, "user1", RoomRoleModerator))
+	for _, userName := range []string{"user1", "user2", "user3"} {
+		event := getUserEvent(t, crs, userName)
+		for event.Type == EventTypeUserJoined {
+			event = getUserEvent(t, crs, userName)
+		}
+		require.Equal(t, EventTypeRoleChanged, event.Type)
+		require.Equal(t, "user1", event.UserName)
+		require.Equal(t, RoomRoleModerator, event.Role)
+	}
+
+	// Moderators can mute members, but not other moderators
+	require.NoError(t, crs.SetUserRole(testShortCode, "user1", "user2", RoomRoleReadOnly))
+	require.Equal(t, EventTypeRoleChanged, getUserEvent(t, crs, "user2").Type)
+	require.ErrorIs(t, crs.SetUserRole(testShortCode, "user2", "user1", RoomRoleReadOnly), ErrNotOwner)
+
+	// Read-only users cannot send messages or reactions
+	require.ErrorIs(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user2", Body: "muted"}), ErrInsufficientRole)
+	require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: "user3", Body: "hello"}))
+	event := getUserEvent(t, crs, "user1")
+	for event.Type != EventTypeMessage {
+		event = getUserEvent(t, crs, "user1")
+	}
+	messageID := event.Message.ID
+	require.ErrorIs(t, crs.AddReaction(testShortCode, "user2", messageID, "👍"), ErrInsufficientRole)
+
+	// Moderators can delete, but not edit messages of other users
+	require.ErrorIs(t, crs.EditMessage(testShortCode, "user1", messageID, "edited"), ErrNotMessageSender)
+	require.ErrorIs(t, crs.DeleteMessage(testShortCode, "user2", messageID), ErrNotMessageSender)
+	require.NoError(t, crs.DeleteMessage(testShortCode, "user1", messageID))
+
+	// Moderators can kick users with lower roles only
+	require.ErrorIs(t, crs.KickUser(testShortCode, "user3", "user2"), ErrInsufficientRole)
+	require.ErrorIs(t, crs.BanUser(testShortCode, "user1", "user3"), ErrNotOwner)
+	require.NoError(t, crs.KickUser(testShortCode, "user1", "user3"))
+
+	// Roles survive restarts
+	restarted, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), roomRepository, messageRepository, reactionRepository, readCursorRepository, roomMemberRepository, banRepository)
+	require.NoError(t, err)
+	for userName, expected := range map[string]RoomRole{"user1": RoomRoleModerator, "user2": RoomRoleReadOnly, "user3": RoomRoleMember} {
+		role, err := restarted.GetUserRole(testShortCode, userName)
+		require.NoError(t, err)
+		require.Equal(t, expected, role)
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	for _, userName := range []string{"user1", "user2"} {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+	require.NoError(t, crs.BanUser(testShortCode, testOwner, "user2"))
+
+	require.ErrorIs(t, crs.TransferOwnership(testShortCode, "user1", "user1"), ErrNotOwner)
+	require.ErrorIs(t, crs.TransferOwnership(testShortCode, testOwner, testOwner), ErrAlreadyOwner)
+	require.ErrorIs(t, crs.TransferOwnership(testShortCode, testOwner, "user3"), ErrUserNotFound)
+	require.ErrorIs(t, crs.TransferOwnership("invalid", testOwner, "user1"), ErrRoomDoesNotExist)
+	require.Eventually(t, func() bool {
+		return errors.Is(crs.TransferOwnership(testShortCode, testOwner, "user2"), ErrUserBanned)
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, crs.TransferOwnership(testShortCode, testOwner, "user1"))
+	event := getUserEvent(t, crs, "user1")
+	for event.Type != EventTypeOwnerChanged {
+		event = getUserEvent(t, crs, "user1")
+	}
+	require.Equal(t, "user1", event.UserName)
+
+	info, err := crs.GetRoomInfo(testShortCode)
+	require.NoError(t, err)
+	require.Equal(t, "user1", info.Owner)
+	require.ErrorIs(t, crs.DeleteRoom(testShortCode, testOwner), ErrNotOwner)
+	require.NoError(t, crs.DeleteRoom(testShortCode, "user1"))
+}
+
+func TestTransferOwnershipConcurrently(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	candidates := []string{"user1", "user2", "user3", "user4"}
+	for _, userName := range candidates {
+		require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+	}
+
+	// All transfers pass the check of the in-memory owner, which changes only once the first transfer is received from the broker.
+	errs := make([]error, len(candidates))
+	var wg sync.WaitGroup
+	for i, userName := range candidates {
+		wg.Add(1)
+		go func(i int, userName string) {
+			defer wg.Done()
+			errs[i] = crs.TransferOwnership(testShortCode, testOwner, userName)
+		}(i, userName)
+	}
+	wg.Wait()
+
+	newOwner := ""
+	for i, err := range errs {
+		if err == nil {
+			require.Empty(t, newOwner, "more than one transfer succeeded")
+			newOwner = candidates[i]
+			continue
+		}
+		require.ErrorIs(t, err, ErrNotOwner)
+	}
+	require.NotEmpty(t, newOwner)
+
+	stored, err := crs.roomRepository.GetRoomByShortCode(context.Background(), testShortCode)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, stored.Owner)
+
+	require.Eventually(t, func() bool {
+		info, err := crs.GetRoomInfo(testShortCode)
+		return err == nil && info.Owner == newOwner
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestOwnerSuccession(t *testing.T) {
+	t.Run("promote", func(t *testing.T) {
+		crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository(), WithOwnerSuccession(OwnerSuccessionPolicyPromote, 0))
+		require.NoError(t, err)
+		require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+		for _, userName := range []string{testOwner, "user1", "user2"} {
+			require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+		}
+		// Waiting for the owner to learn about user2 makes sure the presence of all users has been recorded.
+		event := getUserEvent(t, crs, testOwner)
+		for event.UserName != "user2" {
+			event = getUserEvent(t, crs, testOwner)
+		}
+
+		require.NoError(t, crs.RemoveUserFromRoom(testShortCode, testOwner))
+		event = getUserEvent(t, crs, "user2")
+		for event.Type != EventTypeOwnerChanged {
+			event = getUserEvent(t, crs, "user2")
+		}
+		require.Equal(t, "user1", event.UserName)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository(), WithOwnerSuccession(OwnerSuccessionPolicyDelete, 100*time.Millisecond))
+		require.NoError(t, err)
+		require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+		require.NoError(t, crs.AddUserToRoom(testShortCode, testOwner))
+
+		// The owner joining the room again within the grace period keeps it
+		require.NoError(t, crs.RemoveUserFromRoom(testShortCode, testOwner))
+		require.NoError(t, crs.AddUserToRoom(testShortCode, testOwner))
+		time.Sleep(300 * time.Millisecond)
+		require.True(t, crs.RoomExists(testShortCode))
+
+		require.NoError(t, crs.RemoveUserFromRoom(testShortCode, testOwner))
+		require.Eventually(t, func() bool { return !crs.RoomExists(testShortCode) }, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestReclaimRooms(t *testing.T) {
+	const (
+		expiringShortCode = "EXP123"
+		idleShortCode     = "IDL123"
+	)
+
+	crs, err := NewRoomService(10, 10, SlowConsumerPolicyDefault, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository(), WithRoomReaper(10*time.Millisecond, 300*time.Millisecond))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, crs.CreateRoom(expiringShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{TTL: -time.Second}), ErrInvalidRoomTTL)
+	require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+	require.NoError(t, crs.CreateRoom(idleShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+	require.NoError(t, crs.CreateRoom(expiringShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{TTL: 500 * time.Millisecond}))
+	require.NoError(t, crs.AddUserToRoom(expiringShortCode, "user1"))
+
+	info, err := crs.GetRoomInfo(expiringShortCode)
+	require.NoError(t, err)
+	require.Equal(t, info.CreatedAt.Add(500*time.Millisecond), info.ExpiresAt)
+
+	// Users present in an expired room are notified about its deletion
+	event, err := crs.GetUserEvent(expiringShortCode, "user1")
+	require.NoError(t, err)
+	require.Equal(t, EventTypeRoomDeleted, event.Type)
+	require.False(t, crs.RoomExists(expiringShortCode))
+
+	// Rooms are reclaimed once nobody has been present in them for the idle timeout
+	require.Eventually(t, func() bool { return !crs.RoomExists(idleShortCode) }, time.Second, 10*time.Millisecond)
+	require.True(t, crs.RoomExists(testShortCode))
+}
+
+func TestAdminOperations(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.NoError(t, crs.CreateRoom("ROOM2", "other", testRoomPassword, "owner2", RoomSettings{}))
+	require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+	require.NoError(t, crs.AddUserToRoom("ROOM2", "user2"))
+
+	rooms, err := crs.ListRooms("", "", 10)
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+	require.Equal(t, testShortCode, rooms[0].ShortCode)
+	require.Equal(t, "ROOM2", rooms[1].ShortCode)
+	rooms, err = crs.ListPublicRooms("", "", 10)
+	require.NoError(t, err)
+	require.Empty(t, rooms)
+
+	require.ErrorIs(t, crs.BroadcastAnnouncement("admin", " "), ErrInvalidAnnouncement)
+	require.NoError(t, crs.BroadcastAnnouncement("admin", "maintenance"))
+	event := getUserEvent(t, crs, "user1")
+	require.Equal(t, EventTypeAnnouncement, event.Type)
+	require.Equal(t, "admin", event.Message.Sender)
+	require.Equal(t, "maintenance", event.Message.Body)
+	event, err = crs.GetUserEvent("ROOM2", "user2")
+	require.NoError(t, err)
+	require.Equal(t, EventTypeAnnouncement, event.Type)
+	require.Equal(t, "maintenance", event.Message.Body)
+
+	require.ErrorIs(t, crs.ForceDeleteRoom("invalid"), ErrRoomDoesNotExist)
+	require.NoError(t, crs.ForceDeleteRoom(testShortCode))
+	require.Equal(t, EventTypeRoomDeleted, getUserEvent(t, crs, "user1").Type)
+	require.False(t, crs.RoomExists(testShortCode))
+	require.True(t, crs.RoomExists("ROOM2"))
+}
+
+func TestDeleteRoomWithFullMessageQueue(t *testing.T) {
+	for _, maxMessageQueueSize := range []int{0, 1, 10} {
+		t.Run(fmt.Sprintf("Queue size %d", maxMessageQueueSize), func(t *testing.T) {
+			crs, err := NewRoomService(maxMessageQueueSize, 0, SlowConsumerPolicyDropNewest, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+			require.NoError(t, err)
+			require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+			require.NoError(t, crs.AddUserToRoom(testShortCode, "user1"))
+
+			for i := 0; i < maxMessageQueueSize+5; i++ {
+				require.NoError(t, crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: "hello"}))
+			}
+
+			// The consumer drains the full queue while the room is being deleted.
+			consumed := make(chan error, 1)
+			go func() {
+				for {
+					event, err := crs.GetUserEvent(testShortCode, "user1")
+					if err != nil {
+						consumed <- err
+						return
+					}
+					if event.Type == EventTypeRoomDeleted {
+						consumed <- nil
+						return
+					}
+				}
+			}()
+
+			deleted := make(chan error, 1)
+			go func() {
+				deleted <- crs.DeleteRoom(testShortCode, testOwner)
+			}()
+
+			select {
+			case err := <-deleted:
+				require.NoError(t, err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("room deletion blocked by a full message queue")
+			}
+
+			select {
+			case err := <-consumed:
+				if err != nil {
+					require.ErrorIs(t, err, ErrRoomDoesNotExist)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("user not notified about the room deletion")
+			}
+			require.False(t, crs.RoomExists(testShortCode))
+		})
+	}
+}
+
+func TestCreateRoomInvalidSlowConsumerPolicy(t *testing.T) {
+	crs := newTestRoomService(t, 10, 10)
+	require.ErrorIs(t, crs.CreateRoom("XYZ789", testRoomName, testRoomPassword, testOwner, RoomSettings{SlowConsumerPolicy: 42}), ErrInvalidSlowConsumerPolicy)
+	require.False(t, crs.RoomExists("XYZ789"))
+}
+
+func TestBroadcastMessageToRoomSlowConsumer(t *testing.T) {
+	const (
+		maxMessageQueueSize = 64
+		activeUsers         = 50
+		messages            = 100
+		stalledUser         = "stalled"
+	)
+
+	tests := []struct {
+		policy            SlowConsumerPolicy
+		stalledSequences  []uint64
+		stalledErr        error
+		droppedMessages   uint64
+		disconnectedUsers uint64
+	}{
+		{
+			policy:           SlowConsumerPolicyDropOldest,
+			stalledSequences: sequenceRange(messages-maxMessageQueueSize+1, messages),
+			droppedMessages:  messages - maxMessageQueueSize,
+		},
+		{
+			policy:           SlowConsumerPolicyDropNewest,
+			stalledSequences: sequenceRange(1, maxMessageQueueSize),
+			droppedMessages:  messages - maxMessageQueueSize,
+		},
+		{
+			policy:            SlowConsumerPolicyDisconnect,
+			stalledSequences:  sequenceRange(1, maxMessageQueueSize),
+			stalledErr:        ErrSlowConsumer,
+			disconnectedUsers: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.policy.String(), func(t *testing.T) {
+			crs, err := NewRoomService(maxMessageQueueSize, 0, test.policy, broker.NewInProcessBroker(), repository.NewMockRoomRepository(), repository.NewMockMessageRepository(), repository.NewMockReactionRepository(), repository.NewMockReadCursorRepository(), repository.NewMockRoomMemberRepository(), repository.NewMockBanRepository())
+			require.NoError(t, err)
+			require.NoError(t, crs.CreateRoom(testShortCode, testRoomName, testRoomPassword, testOwner, RoomSettings{}))
+
+			// Every active user acknowledges each received message, so they never fall behind.
+			acks := make(chan struct{}, activeUsers)
+			stalledJoined := make(chan struct{}, activeUsers)
+			received := make([][]uint64, activeUsers)
+			wg := &sync.WaitGroup{}
+			for i := 0; i < activeUsers; i++ {
+				userName := fmt.Sprintf("user%d", i)
+				require.NoError(t, crs.AddUserToRoom(testShortCode, userName))
+
+				wg.Add(1)
+				go func(i int, userName string) {
+					defer wg.Done()
+					for {
+						event, err := crs.GetUserEvent(testShortCode, userName)
+						if err != nil {
+							return
+						}
+						switch {
+						case event.Type == EventTypeMessage:
+							received[i] = append(received[i], event.Message.Sequence)
+							acks <- struct{}{}
+						case event.Type == EventTypeUserJoined && event.UserName == stalledUser:
+							stalledJoined <- struct{}{}
+						}
+					}
+				}(i, userName)
+			}
+
+			// Once all active users know about the stalled user, no more presence events are on the way.
+			require.NoError(t, crs.AddUserToRoom(testShortCode, stalledUser))
+			for i := 0; i < activeUsers; i++ {
+				<-stalledJoined
+			}
+			room := crs.rooms[testShortCode]
+			for len(room.users[stalledUser].messageQueue) > 0 {
+				event, err := crs.GetUserEvent(testShortCode, stalledUser)
+				require.NoError(t, err)
+				require.Equal(t, EventTypeUserJoined, event.Type)
+			}
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for i := 0; i < messages; i++ {
+					if err := crs.BroadcastMessageToRoom(testShortCode, &Message{Sender: testOwner, Body: "hello"}); err != nil {
+						return
+					}
+					for j := 0; j < activeUsers; j++ {
+						<-acks
+					}
+				}
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(10 * time.Second):
+				t.Fatal("broadcast blocked by a stalled user")
+			}
+
+			// Wait for the delivery of the last message to the stalled user.
+			room.deliverMu.Lock()
+			room.deliverMu.Unlock()
+
+			for _, expected := range test.stalledSequences {
+				msg := getUserMessage(t, crs, stalledUser)
+				require.Equal(t, expected, msg.Sequence)
+			}
+			if test.stalledErr != nil {
+				_, err := crs.GetUserEvent(testShortCode, stalledUser)
+				require.ErrorIs(t, err, test.stalledErr)
+			} else {
+				event, err := crs.GetUserEvent(testShortCode, stalledUser)
+				require.NoError(t, err)
+				require.Equal(t, EventTypeMissedMessages, event.Type)
+				require.Equal(t, test.droppedMessages, event.MissedMessages)
+			}
+
+			require.NoError(t, crs.DeleteRoom(testShortCode, testOwner))
+			wg.Wait()
+
+			for i := 0; i < activeUsers; i++ {
+				require.Equal(t, sequenceRange(1, messages), received[i])
+			}
+
+			require.Equal(t, SlowConsumerMetrics{
+				DroppedMessages:   test.droppedMessages,
+				DisconnectedUsers: test.disconnectedUsers,
+			}, crs.GetSlowConsumerMetrics())
+		})
+	}
+}
+
+func sequenceRange(from, to uint64) []uint64 {
+	s := []uint64{}
+	for i := from; i <= to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+// getUserEvent retrieves the next event from the user's message queue.
+func getUserEvent(t *testing.T, crs *RoomServiceImpl, userName string) *Event {
+	t.Helper()
+
+	event, err := crs.GetUserEvent(testShortCode, userName)
+	require.NoError(t, err)
+	return event
+}
+
+// getUserMessage retrieves the next message from the user's message queue, skipping presence events.
+func getUserMessage(t *testing.T, crs *RoomServiceImpl, userName string) *Message {
+	t.Helper()
+
+	for {
+		event, err := crs.GetUserEvent(testShortCode, userName)
+		require.NoError(t, err)
+		if event.Type == EventTypeUserJoined || event.Type == EventTypeUserLeft {
+			continue
+		}
+		require.Equal(t, EventTypeMessage, event.Type)
+		return event.Message
+	}
+}
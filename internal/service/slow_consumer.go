@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SlowConsumerPolicy decides what happens when a message is broadcast to a user whose message queue is full.
+type SlowConsumerPolicy int
+
+const (
+	// SlowConsumerPolicyDefault means that the room service's default policy is used.
+	SlowConsumerPolicyDefault SlowConsumerPolicy = iota
+	// SlowConsumerPolicyDropOldest drops the oldest message from the user's queue to make room for the new one.
+	SlowConsumerPolicyDropOldest
+	// SlowConsumerPolicyDropNewest drops the new message and notifies the user about the number of missed messages once they catch up.
+	SlowConsumerPolicyDropNewest
+	// SlowConsumerPolicyDisconnect removes the user from the room.
+	SlowConsumerPolicyDisconnect
+)
+
+// ErrInvalidSlowConsumerPolicy is returned when an unknown slow consumer policy is provided.
+var ErrInvalidSlowConsumerPolicy = errors.New("invalid slow consumer policy")
+
+var slowConsumerPolicyNames = map[SlowConsumerPolicy]string{
+	SlowConsumerPolicyDefault:    "DEFAULT",
+	SlowConsumerPolicyDropOldest: "DROP_OLDEST",
+	SlowConsumerPolicyDropNewest: "DROP_NEWEST",
+	SlowConsumerPolicyDisconnect: "DISCONNECT",
+}
+
+// String returns the name of the slow consumer policy.
+func (p SlowConsumerPolicy) String() string {
+	if name, ok := slowConsumerPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("SlowConsumerPolicy(%d)", int(p))
+}
+
+// ParseSlowConsumerPolicy parses a slow consumer policy from its name, e.g. DROP_OLDEST.
+func ParseSlowConsumerPolicy(name string) (SlowConsumerPolicy, error) {
+	for policy, policyName := range slowConsumerPolicyNames {
+		if policyName == name {
+			return policy, nil
+		}
+	}
+	return SlowConsumerPolicyDefault, fmt.Errorf("%w: %s", ErrInvalidSlowConsumerPolicy, name)
+}
+
+// SlowConsumerMetrics holds the counters of actions taken because of slow consumers.
+type SlowConsumerMetrics struct {
+	// DroppedMessages is the number of messages dropped from or not added to full message queues.
+	DroppedMessages uint64 `json:"dropped_messages"`
+	// DisconnectedUsers is the number of users removed from rooms because their message queues were full.
+	DisconnectedUsers uint64 `json:"disconnected_users"`
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/MSSkowron/GRPCChatter/internal/dto"
@@ -10,6 +12,18 @@ import (
 	"github.com/MSSkowron/GRPCChatter/internal/repository"
 	"github.com/MSSkowron/GRPCChatter/pkg/crypto"
 	"github.com/MSSkowron/GRPCChatter/pkg/validation"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRefreshTokenDuration is the default duration for which a refresh token is valid.
+	defaultRefreshTokenDuration = 30 * 24 * time.Hour
+	// refreshTokenSize is the number of random bytes a refresh token is generated from.
+	refreshTokenSize = 32
+	// maxExternalUsernameLength is the maximum length of the user name chosen for an external user, excluding its numeric suffix.
+	maxExternalUsernameLength = 64
+	// maxExternalUsernameAttempts is the number of user names tried for an external user before giving up.
+	maxExternalUsernameAttempts = 20
 )
 
 var (
@@ -17,6 +31,8 @@ var (
 	ErrUserAlreadyExists = errors.New("user with the provided user name already exists")
 	// ErrInvalidCredentials is returned when invalid user credentials are provided.
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInvalidRefreshToken is returned when the refresh token is unknown, expired, revoked or has already been used.
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
 )
 
 // UserService defines the interface for user-related operations.
@@ -25,21 +41,43 @@ type UserService interface {
 	RegisterUser(context.Context, *dto.UserRegisterDTO) (*dto.UserDTO, error)
 
 	// LoginUser performs user authentication.
+	// It returns a token together with a refresh token starting a new refresh token family.
 	LoginUser(context.Context, *dto.UserLoginDTO) (*dto.TokenDTO, error)
+
+	// RefreshToken exchanges a refresh token for a new token and refresh token of the same family.
+	// Each refresh token can be used only once. Using it again revokes its whole family, since it means the refresh token has been stolen.
+	RefreshToken(context.Context, *dto.RefreshTokenDTO) (*dto.TokenDTO, error)
+
+	// LogoutUser revokes the family of a refresh token, so that none of its refresh tokens can be used anymore.
+	LogoutUser(context.Context, *dto.RefreshTokenDTO) error
+
+	// LoginExternalUser signs in a user authenticated by an external identity provider.
+	// The user is provisioned the first time they sign in, linked to their subject at the provider.
+	// It returns a token together with a refresh token starting a new refresh token family.
+	LoginExternalUser(context.Context, *dto.ExternalUserDTO) (*dto.TokenDTO, error)
 }
 
 // UserServiceImpl implements the UserService interface.
 type UserServiceImpl struct {
-	tokenService   UserTokenService
-	userRepository repository.UserRepository
+	tokenService           UserTokenService
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	refreshTokenDuration   time.Duration
 }
 
-// NewUserService creates a new UserServiceImpl instance with the provided tokenService and userRepository.
-func NewUserService(tokenService UserTokenService, userRepository repository.UserRepository) *UserServiceImpl {
+// NewUserService creates a new UserServiceImpl instance with the provided tokenService, userRepository, refreshTokenRepository and refreshTokenDuration.
+// If the refreshTokenDuration is not positive, refresh tokens are valid for 30 days.
+func NewUserService(tokenService UserTokenService, userRepository repository.UserRepository, refreshTokenRepository repository.RefreshTokenRepository, refreshTokenDuration time.Duration) *UserServiceImpl {
+	if refreshTokenDuration <= 0 {
+		refreshTokenDuration = defaultRefreshTokenDuration
+	}
+
 	// TODO: Fetch roles from the database and create a map of roles with their IDs.
 	return &UserServiceImpl{
-		tokenService:   tokenService,
-		userRepository: userRepository,
+		tokenService:           tokenService,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		refreshTokenDuration:   refreshTokenDuration,
 	}
 }
 
@@ -86,7 +124,8 @@ func (us *UserServiceImpl) LoginUser(ctx context.Context, userLogin *dto.UserLog
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
+	// External users have no password, so they can only sign in with their identity provider.
+	if user == nil || user.Password == "" {
 		return nil, ErrInvalidCredentials
 	}
 
@@ -98,12 +137,149 @@ func (us *UserServiceImpl) LoginUser(ctx context.Context, userLogin *dto.UserLog
 		return nil, err
 	}
 
+	return us.issueTokens(ctx, user, uuid.New().String())
+}
+
+func (us *UserServiceImpl) RefreshToken(ctx context.Context, refreshTokenDTO *dto.RefreshTokenDTO) (*dto.TokenDTO, error) {
+	refreshToken, err := us.refreshTokenRepository.GetRefreshTokenByHash(ctx, crypto.HashToken(refreshTokenDTO.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if refreshToken == nil || refreshToken.Revoked || time.Now().After(refreshToken.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	used, err := us.refreshTokenRepository.UseRefreshToken(ctx, refreshToken.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		// The refresh token has already been exchanged, so either the user or an attacker holds a stolen one. Neither can be told apart, so both lose the session.
+		if err := us.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// The user is retrieved again, so the new token carries their current role.
+	user, err := us.userRepository.GetUserByID(ctx, refreshToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return us.issueTokens(ctx, user, refreshToken.FamilyID)
+}
+
+func (us *UserServiceImpl) LogoutUser(ctx context.Context, refreshTokenDTO *dto.RefreshTokenDTO) error {
+	refreshToken, err := us.refreshTokenRepository.GetRefreshTokenByHash(ctx, crypto.HashToken(refreshTokenDTO.RefreshToken))
+	if err != nil {
+		return err
+	}
+	if refreshToken == nil {
+		return ErrInvalidRefreshToken
+	}
+
+	return us.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID)
+}
+
+func (us *UserServiceImpl) LoginExternalUser(ctx context.Context, externalUser *dto.ExternalUserDTO) (*dto.TokenDTO, error) {
+	user, err := us.userRepository.GetUserByExternalIdentity(ctx, externalUser.Issuer, externalUser.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		username, err := us.newExternalUsername(ctx, externalUser.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		// An existing user with the same user name is never linked to the identity, since the provider does not prove the user owns that account.
+		user, err = us.userRepository.AddExternalUser(ctx, &model.User{
+			CreatedAt: time.Now(),
+			Username:  username,
+		}, externalUser.Issuer, externalUser.Subject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return us.issueTokens(ctx, user, uuid.New().String())
+}
+
+// newExternalUsername chooses a valid user name, not taken by any other user, for a new external user, based on the preferred one.
+// Characters not allowed in user names are dropped, and a numeric suffix is appended if needed.
+func (us *UserServiceImpl) newExternalUsername(ctx context.Context, preferred string) (string, error) {
+	base := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return -1
+	}, preferred)
+	if len(base) > maxExternalUsernameLength {
+		base = base[:maxExternalUsernameLength]
+	}
+	if len(base) < 5 {
+		base = "user-" + base
+	}
+
+	for attempt := 0; attempt < maxExternalUsernameAttempts; attempt++ {
+		username := base
+		if attempt > 0 {
+			username = fmt.Sprintf("%s%d", base, attempt)
+		}
+		if validation.ValidateUsername(username) != nil {
+			continue
+		}
+
+		user, err := us.userRepository.GetUserByUsername(ctx, username)
+		if err != nil {
+			return "", err
+		}
+		if user == nil {
+			return username, nil
+		}
+	}
+
+	return "", fmt.Errorf("failed to choose a user name for external user %s: %w", preferred, ErrUserAlreadyExists)
+}
+
+// issueTokens generates a token for the user together with a new refresh token of the given family.
+func (us *UserServiceImpl) issueTokens(ctx context.Context, user *model.User, familyID string) (*dto.TokenDTO, error) {
 	token, err := us.tokenService.GenerateToken(user.ID, user.Username, user.Role)
 	if err != nil {
 		return nil, err
 	}
 
+	expiresAt, err := us.tokenService.GetExpirationFromToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := crypto.GenerateToken(refreshTokenSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the hash of the refresh token is stored, so that it cannot be used by anyone reading the database.
+	now := time.Now()
+	if _, err := us.refreshTokenRepository.AddRefreshToken(ctx, &model.RefreshToken{
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		TokenHash: crypto.HashToken(refreshToken),
+		CreatedAt: now,
+		ExpiresAt: now.Add(us.refreshTokenDuration),
+	}); err != nil {
+		return nil, err
+	}
+
 	return &dto.TokenDTO{
-		Token: token,
+		Token:        token,
+		ExpiresAt:    expiresAt,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/dto"
+	"github.com/MSSkowron/GRPCChatter/internal/repository"
+	signing "github.com/MSSkowron/GRPCChatter/pkg/token"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUserName = "user123"
+	testPassword = "Passw0rd!"
+)
+
+func newTestUserService(t *testing.T) *UserServiceImpl {
+	us := NewUserService(NewUserTokenService(signing.NewIssuer("grpcchatter", "grpcchatter-user", signing.NewHMACKeySet("secret")), time.Minute), repository.NewMockUserRepository(), repository.NewMockRefreshTokenRepository(), time.Hour)
+	_, err := us.RegisterUser(context.Background(), &dto.UserRegisterDTO{Username: testUserName, Password: testPassword})
+	require.NoError(t, err)
+	return us
+}
+
+func TestRefreshTokenRotates(t *testing.T) {
+	us := newTestUserService(t)
+
+	login, err := us.LoginUser(context.Background(), &dto.UserLoginDTO{Username: testUserName, Password: testPassword})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.Token)
+	require.NotEmpty(t, login.RefreshToken)
+	require.WithinDuration(t, time.Now().Add(time.Minute), login.ExpiresAt, 2*time.Second)
+
+	refreshed, err := us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+	require.NotEmpty(t, refreshed.Token)
+	require.NotEqual(t, login.RefreshToken, refreshed.RefreshToken)
+
+	userName, err := us.tokenService.GetUserNameFromToken(refreshed.Token)
+	require.NoError(t, err)
+	require.Equal(t, testUserName, userName)
+
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: refreshed.RefreshToken})
+	require.NoError(t, err)
+
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: "unknown"})
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	us := newTestUserService(t)
+
+	login, err := us.LoginUser(context.Background(), &dto.UserLoginDTO{Username: testUserName, Password: testPassword})
+	require.NoError(t, err)
+	otherLogin, err := us.LoginUser(context.Background(), &dto.UserLoginDTO{Username: testUserName, Password: testPassword})
+	require.NoError(t, err)
+
+	refreshed, err := us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: login.RefreshToken})
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	// The refresh token issued in exchange for the reused one belongs to the revoked family.
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: refreshed.RefreshToken})
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	// Other logins are not affected.
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: otherLogin.RefreshToken})
+	require.NoError(t, err)
+}
+
+func TestLogoutUserRevokesFamily(t *testing.T) {
+	us := newTestUserService(t)
+
+	login, err := us.LoginUser(context.Background(), &dto.UserLoginDTO{Username: testUserName, Password: testPassword})
+	require.NoError(t, err)
+	refreshed, err := us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+
+	require.NoError(t, us.LogoutUser(context.Background(), &dto.RefreshTokenDTO{RefreshToken: login.RefreshToken}))
+
+	_, err = us.RefreshToken(context.Background(), &dto.RefreshTokenDTO{RefreshToken: refreshed.RefreshToken})
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	require.ErrorIs(t, us.LogoutUser(context.Background(), &dto.RefreshTokenDTO{RefreshToken: "unknown"}), ErrInvalidRefreshToken)
+}
+
+func TestLoginExternalUser(t *testing.T) {
+	us := newTestUserService(t)
+
+	const issuer = "https://sso.example.com"
+
+	// The preferred user name is already taken by a registered user, who must not be linked to the identity.
+	login, err := us.LoginExternalUser(context.Background(), &dto.ExternalUserDTO{Issuer: issuer, Subject: "1", Username: testUserName})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.RefreshToken)
+
+	userName, err := us.tokenService.GetUserNameFromToken(login.Token)
+	require.NoError(t, err)
+	require.Equal(t, testUserName+"1", userName)
+	userID, err := us.tokenService.GetUserIDFromToken(login.Token)
+	require.NoError(t, err)
+
+	// Signing in again finds the provisioned user.
+	login, err = us.LoginExternalUser(context.Background(), &dto.ExternalUserDTO{Issuer: issuer, Subject: "1", Username: "renamed123"})
+	require.NoError(t, err)
+	sameUserID, err := us.tokenService.GetUserIDFromToken(login.Token)
+	require.NoError(t, err)
+	require.Equal(t, userID, sameUserID)
+
+	// The same subject at another provider is another user.
+	login, err = us.LoginExternalUser(context.Background(), &dto.ExternalUserDTO{Issuer: "https://other.example.com", Subject: "1", Username: testUserName})
+	require.NoError(t, err)
+	userName, err = us.tokenService.GetUserNameFromToken(login.Token)
+	require.NoError(t, err)
+	require.Equal(t, testUserName+"2", userName)
+
+	// Invalid user names are made valid.
+	login, err = us.LoginExternalUser(context.Background(), &dto.ExternalUserDTO{Issuer: issuer, Subject: "2", Username: "jane.doe"})
+	require.NoError(t, err)
+	userName, err = us.tokenService.GetUserNameFromToken(login.Token)
+	require.NoError(t, err)
+	require.Equal(t, "janedoe1", userName)
+
+	login, err = us.LoginExternalUser(context.Background(), &dto.ExternalUserDTO{Issuer: issuer, Subject: "3"})
+	require.NoError(t, err)
+	userName, err = us.tokenService.GetUserNameFromToken(login.Token)
+	require.NoError(t, err)
+	require.Equal(t, "user-1", userName)
+
+	// External users have no password to sign in with.
+	_, err = us.LoginUser(context.Background(), &dto.UserLoginDTO{Username: "janedoe1", Password: ""})
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -3,8 +3,10 @@ package service
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	signing "github.com/MSSkowron/GRPCChatter/pkg/token"
 	token "github.com/MSSkowron/GRPCChatter/pkg/token/usertoken"
 )
 
@@ -27,24 +29,27 @@ type UserTokenService interface {
 
 	// GetUserRoleFromToken retrieves the user role from a user token.
 	GetUserRoleFromToken(string) (string, error)
+
+	// GetExpirationFromToken retrieves the time a user token expires at.
+	GetExpirationFromToken(string) (time.Time, error)
 }
 
 // UserTokenServiceImpl implements the UserTokenService interface.
 type UserTokenServiceImpl struct {
-	secret   string
+	issuer   *signing.Issuer
 	duration time.Duration
 }
 
-// NewUserTokenService creates a new UserTokenServiceImpl instance with the provided secret and duration.
-func NewUserTokenService(secret string, duration time.Duration) *UserTokenServiceImpl {
+// NewUserTokenService creates a new UserTokenServiceImpl instance with the provided issuer and duration.
+func NewUserTokenService(issuer *signing.Issuer, duration time.Duration) *UserTokenServiceImpl {
 	return &UserTokenServiceImpl{
-		secret:   secret,
+		issuer:   issuer,
 		duration: duration,
 	}
 }
 
 func (s *UserTokenServiceImpl) GenerateToken(userID int, userName, userRole string) (string, error) {
-	token, err := token.Generate(userID, userName, userRole, s.duration, s.secret)
+	token, err := token.Generate(userID, userName, userRole, s.duration, s.issuer)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -52,32 +57,45 @@ func (s *UserTokenServiceImpl) GenerateToken(userID int, userName, userRole stri
 }
 
 func (s *UserTokenServiceImpl) ValidateToken(t string) error {
-	if err := token.Validate(t, s.secret); err != nil {
-		return ErrInvalidChatToken
+	if err := token.Validate(t, s.issuer); err != nil {
+		return ErrInvalidUserToken
 	}
 	return nil
 }
 
 func (s *UserTokenServiceImpl) GetUserIDFromToken(t string) (int, error) {
-	userID, err := token.GetClaim[float64](t, s.secret, token.ClaimUserIDKey)
+	subject, err := token.GetClaim[string](t, s.issuer, token.ClaimUserIDKey)
+	if err != nil {
+		return 0, ErrInvalidUserToken
+	}
+
+	userID, err := strconv.Atoi(subject)
 	if err != nil {
-		return 0, ErrInvalidChatToken
+		return 0, ErrInvalidUserToken
 	}
-	return int(userID), nil
+	return userID, nil
 }
 
 func (s *UserTokenServiceImpl) GetUserNameFromToken(t string) (string, error) {
-	userName, err := token.GetClaim[string](t, s.secret, token.ClaimUserNameKey)
+	userName, err := token.GetClaim[string](t, s.issuer, token.ClaimUserNameKey)
 	if err != nil {
-		return "", ErrInvalidChatToken
+		return "", ErrInvalidUserToken
 	}
 	return userName, nil
 }
 
 func (s *UserTokenServiceImpl) GetUserRoleFromToken(t string) (string, error) {
-	userRole, err := token.GetClaim[string](t, s.secret, token.ClaimUserRoleKey)
+	userRole, err := token.GetClaim[string](t, s.issuer, token.ClaimUserRoleKey)
 	if err != nil {
-		return "", ErrInvalidChatToken
+		return "", ErrInvalidUserToken
 	}
 	return userRole, nil
 }
+
+func (s *UserTokenServiceImpl) GetExpirationFromToken(t string) (time.Time, error) {
+	expiresAt, err := token.GetClaim[float64](t, s.issuer, token.ClaimExpiresAtKey)
+	if err != nil {
+		return time.Time{}, ErrInvalidUserToken
+	}
+	return time.Unix(int64(expiresAt), 0), nil
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
+	"google.golang.org/grpc/metadata"
+)
+
+// ListAllChatRooms retrieves up to limit chat rooms, both public and private, whose names contain nameFilter, ignoring case.
+// The cursor is the one returned with the previous page, or empty for the first page. The returned cursor is empty when there are no more chat rooms.
+// If the limit is 0, the server's default limit is used. Only users with the ADMIN role can list all chat rooms.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) ListAllChatRooms(nameFilter, cursor string, limit int) ([]ChatRoom, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return nil, "", err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.adminClient.ListAllChatRooms(ctx, &proto.ListChatRoomsRequest{
+		NameFilter: nameFilter,
+		Cursor:     cursor,
+		Limit:      int32(limit),
+	}, authorizedCall)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list all chat rooms: %w", err)
+	}
+
+	return newChatRooms(resp.GetRooms()), resp.GetNextCursor(), nil
+}
+
+// ForceDeleteChatRoom deletes a chat room with the provided short code, regardless of its owner.
+// Only users with the ADMIN role can force the deletion of chat rooms.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) ForceDeleteChatRoom(shortCode string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.adminClient.ForceDeleteChatRoom(ctx, &proto.DeleteChatRoomRequest{
+		ShortCode: shortCode,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to force delete chat room: %w", err)
+	}
+
+	return nil
+}
+
+// ListConnectedUsers retrieves the list of users currently connected to a chat room with the provided short code.
+// Only users with the ADMIN role can list the users of any chat room.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) ListConnectedUsers(shortCode string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return nil, err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return nil, err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.adminClient.ListConnectedUsers(ctx, &proto.ListConnectedUsersRequest{
+		ShortCode: shortCode,
+	}, authorizedCall)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connected users: %w", err)
+	}
+
+	users := make([]string, 0, len(resp.GetUsers()))
+	for _, user := range resp.GetUsers() {
+		users = append(users, user.GetUserName())
+	}
+
+	return users, nil
+}
+
+// BroadcastAnnouncement sends an announcement to all users in all chat rooms, who receive an AnnouncementEvent.
+// Only users with the ADMIN role can broadcast announcements.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) BroadcastAnnouncement(body string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.adminClient.BroadcastAnnouncement(ctx, &proto.BroadcastAnnouncementRequest{
+		Body: body,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast announcement: %w", err)
+	}
+
+	return nil
+}
@@ -7,14 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/MSSkowron/GRPCChatter/internal/dto"
 	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -30,6 +37,21 @@ var (
 	ErrNotJoinedChatRoom = errors.New("client has not joined any chat room")
 	// ErrNotLoggedIn is returned when a client is not logged in.
 	ErrNotLoggedIn = errors.New("client is not logged in")
+	// ErrRecipientNotFound is returned by Receive when the recipient of a previously sent private message is not in the chat room.
+	ErrRecipientNotFound = errors.New("recipient not found in the chat room")
+	// ErrInvalidRecipient is returned by Receive when a previously sent private message was addressed to the sender.
+	ErrInvalidRecipient = errors.New("invalid recipient")
+	// ErrMessageNotFound is returned by Receive when a previously sent reply or reaction refers to a message that does not exist in the chat room.
+	ErrMessageNotFound = errors.New("message not found in the chat room")
+	// ErrInvalidReaction is returned by Receive when a previously sent reaction is empty or too long.
+	ErrInvalidReaction = errors.New("invalid reaction")
+	// ErrInvalidReadSequence is returned by Receive when messages have been previously marked as read up to a sequence number that has not been assigned in the chat room yet.
+	ErrInvalidReadSequence = errors.New("invalid read sequence")
+	// ErrPermissionDenied is returned by Receive when the server has ended the chat stream because the client's user is not permitted to take part in the chat room anymore,
+	// e.g. a previously sent message or reaction has been rejected because the user is read-only in it. The connection is closed afterwards.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrMessageTooLarge is returned by Receive when a previously sent message has been rejected because its body is too large.
+	ErrMessageTooLarge = errors.New("message too large")
 )
 
 // Client represents a chat client.
@@ -40,29 +62,234 @@ type Client struct {
 	mu         sync.RWMutex
 	conn       *grpc.ClientConn
 	grpcClient proto.GRPCChatterClient
-	stream     proto.GRPCChatter_ChatClient
-	chatToken  string
-	authToken  string
+	// adminClient calls the methods of the GRPCChatterAdmin service, which require the ADMIN role.
+	adminClient proto.GRPCChatterAdminClient
+	stream      proto.GRPCChatter_ChatClient
+	chatToken   string
+	authToken   string
+	// authTokenExpiresAt is the time the authorization token expires at. The token is refreshed with the refresh token shortly before.
+	authTokenExpiresAt time.Time
+	refreshToken       string
+	// chatTokenExpiresAt is the time the chat token expires at. The token is refreshed shortly before.
+	chatTokenExpiresAt time.Time
+	// userName is the name of the logged in user. The chat token is refreshed when their role in the chat room changes.
+	userName string
 
-	receiveQueue chan Message
-	sendQueue    chan string
+	receiveQueue chan received
+	sendQueue    chan *proto.ClientMessage
 
+	reconnectPolicy *ReconnectPolicy
+	stateHandler    func(ConnectionState)
+	lastSequence    uint64
+	streamChangedCh chan struct{}
+
+	// leaveCh is closed when the client leaves the chat room, ending the chat stream gracefully.
+	leaveCh chan struct{}
 	closeCh chan struct{}
 	wg      sync.WaitGroup
 }
 
+const (
+	// authTokenRefreshMargin is how long before its expiration the authorization token is refreshed.
+	authTokenRefreshMargin = 30 * time.Second
+	// chatTokenRefreshMargin is how long before its expiration the chat token is refreshed.
+	chatTokenRefreshMargin = time.Minute
+	// leaveTimeout is how long Disconnect waits for the server to end the chat stream after leaving the chat room.
+	leaveTimeout = time.Second
+)
+
 // Message represents an incoming chat message.
 type Message struct {
-	Sender string // Sender is the name of the user who sent the message.
-	Body   string // Body contains the content of the chat message.
+	ID        string    // ID is the server-assigned unique identifier of the message.
+	Sequence  uint64    // Sequence is the number of the message, monotonically increasing within a chat room. It can be used to detect gaps.
+	Timestamp time.Time // Timestamp is the time the message was received by the server.
+	Sender    string    // Sender is the name of the user who sent the message.
+	Body      string    // Body contains the content of the chat message.
+	// Private is true if the message has been sent only to this client.
+	Private bool
+	// EditedAt is the time the message was last edited at. It is zero for messages that have not been edited.
+	EditedAt time.Time
+	// ReplyTo is the ID of the message this message replies to. It is empty for messages that are not replies.
+	ReplyTo string
+}
+
+// HistoryMessage represents a chat message retrieved from the chat room history.
+type HistoryMessage struct {
+	ID        int64     // ID is the identifier of the message, used as a cursor for paging through the history.
+	MessageID string    // MessageID is the server-assigned unique identifier of the message, the same as Message.ID.
+	Sequence  uint64    // Sequence is the number of the message, monotonically increasing within a chat room.
+	Sender    string    // Sender is the name of the user who sent the message.
+	Body      string    // Body contains the content of the chat message.
+	CreatedAt time.Time // CreatedAt is the time the message was sent at.
+	Recipient string    // Recipient is the name of the only user the message was sent to, empty if it was sent to all users.
+	EditedAt  time.Time // EditedAt is the time the message was last edited at, zero if it has not been edited.
+	ReplyTo   string    // ReplyTo is the ID of the message this message replies to, empty if it is not a reply.
+	// Reactions holds the number of users who reacted to the message, by emoji.
+	Reactions map[string]int
+}
+
+// ChatRoom represents a chat room listed by ListChatRooms or ListAllChatRooms.
+type ChatRoom struct {
+	ShortCode   string    // ShortCode is the short code used to join the chat room.
+	Name        string    // Name is the name of the chat room.
+	Owner       string    // Owner is the name of the user who created the chat room.
+	MemberCount int       // MemberCount is the number of users currently in the chat room.
+	CreatedAt   time.Time // CreatedAt is the time the chat room was created at.
+	Public      bool      // Public is true if the chat room is listed by ListChatRooms.
+}
+
+// ChatRoomInfo represents the information about a chat room.
+type ChatRoomInfo struct {
+	ShortCode          string             // ShortCode is the short code used to join the chat room.
+	Name               string             // Name is the name of the chat room.
+	Description        string             // Description is the description or topic of the chat room.
+	Owner              string             // Owner is the name of the user who created the chat room.
+	CreatedAt          time.Time          // CreatedAt is the time the chat room was created at.
+	MemberCount        int                // MemberCount is the number of users currently in the chat room.
+	SlowConsumerPolicy SlowConsumerPolicy // SlowConsumerPolicy is the slow consumer policy of the chat room.
+	Public             bool               // Public is true if the chat room is listed by ListChatRooms.
+	ExpiresAt          time.Time          // ExpiresAt is the time the chat room expires and is deleted at, or zero time if it never expires.
+}
+
+// UnreadCount represents the number of unread messages in a chat room the user is a member of.
+type UnreadCount struct {
+	ShortCode        string // ShortCode is the short code of the chat room.
+	RoomName         string // RoomName is the name of the chat room.
+	LastReadSequence uint64 // LastReadSequence is the sequence number of the last message the user has read in the chat room.
+	Count            uint64 // Count is the number of messages sent by other users after the last read one.
+}
+
+// received is an event or an error event received from the server.
+type received struct {
+	event Event
+	err   error
 }
 
 // NewClient creates a new chat client with the given name and server address.
-func NewClient(restServerAddress, grpcServerAddres string) *Client {
-	return &Client{
+func NewClient(restServerAddress, grpcServerAddres string, opts ...Opt) *Client {
+	client := &Client{
 		restServerAddress: restServerAddress,
 		grpcServerAddress: grpcServerAddres,
 	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// Opt represents an option that can be passed to NewClient.
+type Opt func(*Client)
+
+// WithReconnectPolicy enables automatic re-establishment of an interrupted chat stream according to the given policy.
+// Messages sent in the chat room in the meantime are replayed by the server, as long as they are still held in its replay buffer.
+func WithReconnectPolicy(policy ReconnectPolicy) Opt {
+	return func(c *Client) {
+		c.reconnectPolicy = &policy
+	}
+}
+
+// WithConnectionStateHandler sets a handler called whenever the state of the chat stream changes.
+// The handler is called synchronously from the client's internal goroutines, so it should return quickly.
+func WithConnectionStateHandler(handler func(ConnectionState)) Opt {
+	return func(c *Client) {
+		c.stateHandler = handler
+	}
+}
+
+// SlowConsumerPolicy determines what the server does when the client of a chat room member does not keep up with messages.
+type SlowConsumerPolicy int32
+
+const (
+	// SlowConsumerPolicyDefault leaves the choice of the policy to the server.
+	SlowConsumerPolicyDefault SlowConsumerPolicy = SlowConsumerPolicy(proto.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_UNSPECIFIED)
+	// SlowConsumerPolicyDropOldest drops the oldest undelivered message to make room for a new one.
+	SlowConsumerPolicyDropOldest SlowConsumerPolicy = SlowConsumerPolicy(proto.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST)
+	// SlowConsumerPolicyDropNewest drops new messages until the member catches up.
+	SlowConsumerPolicyDropNewest SlowConsumerPolicy = SlowConsumerPolicy(proto.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_NEWEST)
+	// SlowConsumerPolicyDisconnect removes the member from the chat room.
+	SlowConsumerPolicyDisconnect SlowConsumerPolicy = SlowConsumerPolicy(proto.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT)
+)
+
+// RoomRole is the role of a user in a chat room, which decides what they can do in it.
+type RoomRole int32
+
+const (
+	// RoomRoleReadOnly lets the user receive messages, but not send messages or reactions.
+	RoomRoleReadOnly RoomRole = RoomRole(proto.RoomRole_ROOM_ROLE_READ_ONLY)
+	// RoomRoleMember lets the user send messages and reactions. It is the role of users who join the chat room.
+	RoomRoleMember RoomRole = RoomRole(proto.RoomRole_ROOM_ROLE_MEMBER)
+	// RoomRoleModerator additionally lets the user kick and mute members and delete messages of other users.
+	RoomRoleModerator RoomRole = RoomRole(proto.RoomRole_ROOM_ROLE_MODERATOR)
+	// RoomRoleOwner is the role of the user who created the chat room.
+	RoomRoleOwner RoomRole = RoomRole(proto.RoomRole_ROOM_ROLE_OWNER)
+)
+
+// String returns the name of the room role.
+func (r RoomRole) String() string {
+	switch r {
+	case RoomRoleReadOnly:
+		return "read-only"
+	case RoomRoleMember:
+		return "member"
+	case RoomRoleModerator:
+		return "moderator"
+	case RoomRoleOwner:
+		return "owner"
+	default:
+		return fmt.Sprintf("RoomRole(%d)", int32(r))
+	}
+}
+
+// RoomOpt represents an option that can be passed to CreateChatRoom.
+type RoomOpt func(*proto.CreateChatRoomRequest)
+
+// WithSlowConsumerPolicy sets the slow consumer policy of the created chat room.
+func WithSlowConsumerPolicy(policy SlowConsumerPolicy) RoomOpt {
+	return func(req *proto.CreateChatRoomRequest) {
+		req.SlowConsumerPolicy = proto.SlowConsumerPolicy(policy)
+	}
+}
+
+// WithPublic makes the created chat room public, so that it is listed by ListChatRooms.
+// Chat rooms are private by default and can be joined only by users who know their short code.
+func WithPublic() RoomOpt {
+	return func(req *proto.CreateChatRoomRequest) {
+		req.Public = true
+	}
+}
+
+// WithTTL makes the created chat room expire and be deleted after the provided time to live.
+// Chat rooms never expire by default, though the server may delete chat rooms nobody has been present in for a long time.
+func WithTTL(ttl time.Duration) RoomOpt {
+	return func(req *proto.CreateChatRoomRequest) {
+		req.Ttl = durationpb.New(ttl)
+	}
+}
+
+// UpdateOpt represents an option that can be passed to UpdateChatRoom.
+type UpdateOpt func(*proto.UpdateChatRoomRequest)
+
+// WithRoomName changes the name of the updated chat room.
+func WithRoomName(name string) UpdateOpt {
+	return func(req *proto.UpdateChatRoomRequest) {
+		req.RoomName = &name
+	}
+}
+
+// WithRoomPassword changes the password of the updated chat room.
+func WithRoomPassword(password string) UpdateOpt {
+	return func(req *proto.UpdateChatRoomRequest) {
+		req.RoomPassword = &password
+	}
+}
+
+// WithDescription changes the description or topic of the updated chat room.
+func WithDescription(description string) UpdateOpt {
+	return func(req *proto.UpdateChatRoomRequest) {
+		req.Description = &description
+	}
 }
 
 // Register registers the user with the server.
@@ -108,12 +335,119 @@ func (c *Client) Login(username, password string) error {
 		return c.handleErrorResponse(resp)
 	}
 
+	if err := c.setTokens(resp); err != nil {
+		return err
+	}
+	c.userName = username
+
+	return nil
+}
+
+// Logout logs out the user, revoking the refresh token obtained at login and all the ones it has been exchanged for.
+// The authorization token remains valid until it expires, but it is no longer refreshed. The client has to log in again before the next usage.
+func (c *Client) Logout() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.refreshToken == "" {
+		return ErrNotLoggedIn
+	}
+
+	resp, err := c.postJSON(fmt.Sprintf("http://%s/logout", c.restServerAddress), dto.RefreshTokenDTO{
+		RefreshToken: c.refreshToken,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return c.handleErrorResponse(resp)
+	}
+
+	c.authToken, c.authTokenExpiresAt, c.refreshToken = "", time.Time{}, ""
+
+	return nil
+}
+
+// authorize makes sure the client is logged in, refreshing the authorization token first if it is about to expire.
+// It should be called with the c.mu read-write mutex locked.
+func (c *Client) authorize() error {
+	if c.authToken == "" {
+		return ErrNotLoggedIn
+	}
+	if c.refreshToken == "" || time.Until(c.authTokenExpiresAt) > authTokenRefreshMargin {
+		return nil
+	}
+
+	err := c.refreshAuthToken()
+	// The current token can still be used if it has not expired yet, for example when the REST server is temporarily unavailable.
+	if err != nil && time.Now().After(c.authTokenExpiresAt) {
+		return err
+	}
+
+	return nil
+}
+
+// refreshAuthToken exchanges the refresh token for a new authorization token and refresh token.
+// It should be called with the c.mu read-write mutex locked.
+func (c *Client) refreshAuthToken() error {
+	resp, err := c.postJSON(fmt.Sprintf("http://%s/refresh", c.restServerAddress), dto.RefreshTokenDTO{
+		RefreshToken: c.refreshToken,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// The refresh token has expired or been revoked, so the user has to log in again.
+		c.authToken, c.authTokenExpiresAt, c.refreshToken = "", time.Time{}, ""
+		return fmt.Errorf("%w: %w", ErrNotLoggedIn, c.handleErrorResponse(resp))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return c.handleErrorResponse(resp)
+	}
+
+	return c.setTokens(resp)
+}
+
+// authorizedCallOption marks a gRPC call authorized with the authorization token, which is made with the c.mu read-write mutex locked.
+type authorizedCallOption struct {
+	grpc.EmptyCallOption
+}
+
+// authorizedCall is passed to every gRPC call authorized with the authorization token.
+var authorizedCall grpc.CallOption = authorizedCallOption{}
+
+// retryUnauthenticated is a unary client interceptor, which refreshes the authorization token and retries an authorized call once, if the server rejects the token as unauthenticated.
+// The token is normally refreshed before it expires, but the server may still consider it expired, for example when the clocks of the client and the server differ.
+func (c *Client) retryUnauthenticated(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if status.Code(err) != codes.Unauthenticated || !slices.Contains(opts, authorizedCall) || c.refreshToken == "" {
+		return err
+	}
+
+	if refreshErr := c.refreshAuthToken(); refreshErr != nil {
+		return err
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("token", c.authToken)
+
+	return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+}
+
+// setTokens sets the authorization token and refresh token from the response to a login or refresh request.
+// It should be called with the c.mu read-write mutex locked.
+func (c *Client) setTokens(resp *http.Response) error {
 	respBody := dto.TokenDTO{}
 	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
 		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	c.authToken = respBody.Token
+	c.authToken, c.authTokenExpiresAt, c.refreshToken = respBody.Token, respBody.ExpiresAt, respBody.RefreshToken
 
 	return nil
 }
@@ -121,46 +455,679 @@ func (c *Client) Login(username, password string) error {
 // CreateChatRoom creates a new chat room with the provided name and password.
 // Upon successful creation, it returns the shortcode of the newly created chat room.
 // The Login() method must be called before the first usage while it requires authorization token.
-func (c *Client) CreateChatRoom(roomName, roomPassword string) (string, error) {
+func (c *Client) CreateChatRoom(roomName, roomPassword string, opts ...RoomOpt) (string, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.authToken == "" {
-		return "", ErrNotLoggedIn
+	if err := c.authorize(); err != nil {
+		return "", err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return "", err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	req := &proto.CreateChatRoomRequest{
+		RoomName:     roomName,
+		RoomPassword: roomPassword,
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.grpcClient.CreateChatRoom(ctx, req, authorizedCall)
+	if err != nil {
+		return "", fmt.Errorf("failed to create chat room: %w", err)
+	}
+
+	return resp.GetShortCode(), nil
+}
+
+// ListChatRooms retrieves up to limit public chat rooms whose names contain nameFilter, ignoring case.
+// The cursor is the one returned with the previous page, or empty for the first page. The returned cursor is empty when there are no more chat rooms.
+// If the limit is 0, the server's default limit is used.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) ListChatRooms(nameFilter, cursor string, limit int) ([]ChatRoom, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return nil, "", err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.grpcClient.ListChatRooms(ctx, &proto.ListChatRoomsRequest{
+		NameFilter: nameFilter,
+		Cursor:     cursor,
+		Limit:      int32(limit),
+	}, authorizedCall)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list chat rooms: %w", err)
+	}
+
+	return newChatRooms(resp.GetRooms()), resp.GetNextCursor(), nil
+}
+
+func newChatRooms(resRooms []*proto.ChatRoom) []ChatRoom {
+	rooms := make([]ChatRoom, 0, len(resRooms))
+	for _, room := range resRooms {
+		rooms = append(rooms, ChatRoom{
+			ShortCode:   room.GetShortCode(),
+			Name:        room.GetName(),
+			Owner:       room.GetOwner(),
+			MemberCount: int(room.GetMemberCount()),
+			CreatedAt:   room.GetCreatedAt().AsTime(),
+			Public:      room.GetPublic(),
+		})
+	}
+
+	return rooms
+}
+
+// GetChatRoomInfo retrieves the information about a chat room with the provided short code.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) GetChatRoomInfo(shortCode string) (ChatRoomInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return ChatRoomInfo{}, err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return ChatRoomInfo{}, err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.grpcClient.GetChatRoomInfo(ctx, &proto.GetChatRoomInfoRequest{
+		ShortCode: shortCode,
+	}, authorizedCall)
+	if err != nil {
+		return ChatRoomInfo{}, fmt.Errorf("failed to get chat room info: %w", err)
+	}
+
+	return ChatRoomInfo{
+		ShortCode:          resp.GetShortCode(),
+		Name:               resp.GetName(),
+		Description:        resp.GetDescription(),
+		Owner:              resp.GetOwner(),
+		CreatedAt:          resp.GetCreatedAt().AsTime(),
+		MemberCount:        int(resp.GetMemberCount()),
+		SlowConsumerPolicy: SlowConsumerPolicy(resp.GetSlowConsumerPolicy()),
+		Public:             resp.GetPublic(),
+		ExpiresAt:          getTime(resp.GetExpiresAt()),
+	}, nil
+}
+
+// UpdateChatRoom changes the name, password or description of a chat room with the provided short code, as given by the options.
+// Only the owner of the chat room can update it. Users in the chat room receive a RoomUpdatedEvent.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) UpdateChatRoom(shortCode string, opts ...UpdateOpt) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	req := &proto.UpdateChatRoomRequest{
+		ShortCode: shortCode,
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	if _, err := c.grpcClient.UpdateChatRoom(ctx, req, authorizedCall); err != nil {
+		return fmt.Errorf("failed to update chat room: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteChatRoom deletes a chat room with the provided short code.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) DeleteChatRoom(shortCode string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.DeleteChatRoom(ctx, &proto.DeleteChatRoomRequest{
+		ShortCode: shortCode,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to delete chat room: %w", err)
+	}
+
+	return nil
+}
+
+// TransferChatRoomOwnership makes a member with the provided user name the owner of a chat room with the provided short code.
+// The client's user becomes a regular member of the chat room. Only the owner of the chat room can transfer its ownership.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) TransferChatRoomOwnership(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.TransferChatRoomOwnership(ctx, &proto.TransferChatRoomOwnershipRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to transfer chat room ownership: %w", err)
+	}
+
+	return nil
+}
+
+// KickUser removes a user with the provided user name from a chat room with the provided short code.
+// The user can join the chat room again, but the chat token they have been using is no longer valid.
+// Only the owner and moderators of the chat room can kick users, provided the kicked user has a lower role.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) KickUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.KickUser(ctx, &proto.KickUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to kick user: %w", err)
+	}
+
+	return nil
+}
+
+// BanUser removes a user with the provided user name from a chat room with the provided short code, if they are in it, and prevents them from joining it again.
+// Only the owner of the chat room can ban users.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) BanUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.BanUser(ctx, &proto.BanUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to ban user: %w", err)
+	}
+
+	return nil
+}
+
+// UnbanUser lets a banned user with the provided user name join a chat room with the provided short code again.
+// Only the owner of the chat room can unban users.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) UnbanUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.UnbanUser(ctx, &proto.UnbanUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to unban user: %w", err)
+	}
+
+	return nil
+}
+
+// PromoteUser makes a member with the provided user name a moderator of a chat room with the provided short code.
+// Only the owner of the chat room can promote users.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) PromoteUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.PromoteUser(ctx, &proto.PromoteUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to promote user: %w", err)
+	}
+
+	return nil
+}
+
+// DemoteUser makes a moderator with the provided user name a regular member of a chat room with the provided short code.
+// Only the owner of the chat room can demote users.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) DemoteUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.DemoteUser(ctx, &proto.DemoteUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to demote user: %w", err)
+	}
+
+	return nil
+}
+
+// MuteUser makes a member with the provided user name read-only in a chat room with the provided short code, so that they can no longer send messages or reactions.
+// The owner and moderators of the chat room can mute members, while only the owner can mute moderators.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) MuteUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.MuteUser(ctx, &proto.MuteUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to mute user: %w", err)
+	}
+
+	return nil
+}
+
+// UnmuteUser lets a read-only member with the provided user name send messages and reactions in a chat room with the provided short code again.
+// The owner and moderators of the chat room can unmute members.
+// The Login() method must be called before the first usage while it requires authorization token.
+func (c *Client) UnmuteUser(shortCode, userName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := c.grpcClient.UnmuteUser(ctx, &proto.UnmuteUserRequest{
+		ShortCode: shortCode,
+		UserName:  userName,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to unmute user: %w", err)
+	}
+
+	return nil
+}
+
+// JoinChatRoom connects the client to a specific chat room.
+// Returns ErrAlreadyJoined if the client is already connected to a chat room. To leave the current chat room, use the Disconnect method.
+// The Login() method must be called before the first usage.
+func (c *Client) JoinChatRoom(shortCode string, password string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.authorize(); err != nil {
+		return err
+	}
+	if c.stream != nil {
+		return ErrAlreadyJoinedChatRoom
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	md := metadata.New(map[string]string{
+		"token": c.authToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.grpcClient.JoinChatRoom(ctx, &proto.JoinChatRoomRequest{
+		ShortCode:    shortCode,
+		RoomPassword: password,
+	}, authorizedCall)
+	if err != nil {
+		return fmt.Errorf("failed to join the chat room: %w", err)
+	}
+	c.chatToken, c.chatTokenExpiresAt = resp.GetToken(), resp.GetExpiresAt().AsTime()
+
+	md = metadata.New(map[string]string{
+		"token": c.chatToken,
+	})
+	ctx = metadata.NewOutgoingContext(context.Background(), md)
+	stream, err := c.grpcClient.Chat(ctx)
+	if err != nil {
+		c.conn.Close()
+		return fmt.Errorf("failed to establish a chat stream: %w", err)
+	}
+	// The server sends the headers once the stream has been established, so the chat token cannot be refreshed before it is checked.
+	header, err := stream.Header()
+	if err == nil && header == nil {
+		_, err = stream.Recv()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to establish a chat stream: %w", err)
+	}
+	c.stream = stream
+	c.lastSequence = 0
+	c.streamChangedCh = make(chan struct{})
+
+	c.receiveQueue = make(chan received)
+	c.sendQueue = make(chan *proto.ClientMessage)
+
+	c.leaveCh = make(chan struct{})
+	c.closeCh = make(chan struct{})
+
+	c.wg.Add(2)
+	go c.send()
+	go c.receive()
+
+	c.notifyState(StateConnected)
+
+	return nil
+}
+
+// RefreshChatToken replaces the chat token of the currently joined chat room with a new one, which also carries the user's current role in it.
+// The previous chat token is revoked. The client refreshes the chat token on its own shortly before it expires and when the user's role changes, so calling this method is rarely needed.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) RefreshChatToken() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return ErrConnectionNotExist
+	}
+	if c.stream == nil {
+		return ErrStreamNotExist
+	}
+
+	return c.refreshChatToken()
+}
+
+// It should be called with the c.mu read-write mutex locked.
+func (c *Client) refreshChatToken() error {
+	md := metadata.New(map[string]string{
+		"token": c.chatToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.grpcClient.RefreshChatToken(ctx, &emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("failed to refresh the chat token: %w", err)
+	}
+	c.chatToken, c.chatTokenExpiresAt = resp.GetToken(), resp.GetExpiresAt().AsTime()
+
+	return nil
+}
+
+// reissueChatToken refreshes the chat token, so that it carries the user's current role in the chat room.
+// The server checks the role on every call rather than trusting the token, so a failure only leaves the role in the token outdated until the next refresh.
+// It should be called without the c.mu read-write mutex locked.
+func (c *Client) reissueChatToken() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.grpcClient == nil {
+		return
+	}
+	_ = c.refreshChatToken()
+}
+
+// getChatToken returns the chat token, refreshing it first if it is about to expire.
+// It should be called without the c.mu read-write mutex locked.
+func (c *Client) getChatToken() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.grpcClient == nil {
+		return "", ErrConnectionClosed
+	}
+	if time.Until(c.chatTokenExpiresAt) < chatTokenRefreshMargin {
+		if err := c.refreshChatToken(); err != nil {
+			return "", err
+		}
+	}
+
+	return c.chatToken, nil
+}
+
+// ListChatRoomUsers retrieves the list of users in the currently joined chat room.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) ListChatRoomUsers() ([]string, error) {
+	c.mu.RLock()
+	if c.conn == nil {
+		c.mu.RUnlock()
+		return nil, ErrConnectionNotExist
+	}
+	if c.stream == nil {
+		c.mu.RUnlock()
+		return nil, ErrStreamNotExist
+	}
+	c.mu.RUnlock()
+
+	chatToken, err := c.getChatToken()
+	if err != nil {
+		return nil, err
+	}
+
+	md := metadata.New(map[string]string{
+		"token": chatToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := c.grpcClient.ListChatRoomUsers(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the chat room users: %w", err)
+	}
+
+	users := make([]string, len(resp.Users))
+	for _, user := range resp.GetUsers() {
+		users = append(users, user.GetUserName())
+	}
+
+	return users, nil
+}
+
+// GetChatHistory retrieves up to limit messages previously sent in the currently joined chat room, newest first.
+// Only messages with an ID lower than the cursor are returned. If the cursor is 0, the newest messages are returned.
+// If limit is 0, the server's default limit is used.
+// It returns the messages and the cursor for the next page, which is 0 if there are no more messages.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) GetChatHistory(cursor int64, limit int) ([]HistoryMessage, int64, error) {
+	c.mu.RLock()
+	if c.conn == nil {
+		c.mu.RUnlock()
+		return nil, 0, ErrConnectionNotExist
+	}
+	if c.stream == nil {
+		c.mu.RUnlock()
+		return nil, 0, ErrStreamNotExist
 	}
-	if c.conn == nil {
-		if err := c.connect(); err != nil {
-			return "", err
-		}
+	c.mu.RUnlock()
+
+	chatToken, err := c.getChatToken()
+	if err != nil {
+		return nil, 0, err
 	}
 
 	md := metadata.New(map[string]string{
-		"token": c.authToken,
+		"token": chatToken,
 	})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	resp, err := c.grpcClient.CreateChatRoom(ctx, &proto.CreateChatRoomRequest{
-		RoomName:     roomName,
-		RoomPassword: roomPassword,
+	resp, err := c.grpcClient.GetChatHistory(ctx, &proto.GetChatHistoryRequest{
+		Cursor: cursor,
+		Limit:  int32(limit),
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create chat room: %w", err)
+		return nil, 0, fmt.Errorf("failed to get the chat history: %w", err)
 	}
 
-	return resp.GetShortCode(), nil
+	messages := make([]HistoryMessage, 0, len(resp.GetMessages()))
+	for _, msg := range resp.GetMessages() {
+		var reactions map[string]int
+		for _, reaction := range msg.GetReactions() {
+			if reactions == nil {
+				reactions = make(map[string]int)
+			}
+			reactions[reaction.GetEmoji()] = int(reaction.GetCount())
+		}
+
+		messages = append(messages, HistoryMessage{
+			ID:        msg.GetId(),
+			MessageID: msg.GetMessageId(),
+			Sequence:  msg.GetSequence(),
+			Sender:    msg.GetUserName(),
+			Body:      msg.GetBody(),
+			CreatedAt: msg.GetCreatedAt().AsTime(),
+			Recipient: msg.GetRecipient(),
+			EditedAt:  getTime(msg.GetEditedAt()),
+			ReplyTo:   msg.GetReplyTo(),
+			Reactions: reactions,
+		})
+	}
+
+	return messages, resp.GetNextCursor(), nil
 }
 
-// DeleteChatRoom deletes a chat room with the provided short code.
+// GetUnreadCount retrieves the number of unread messages in each chat room the user has ever joined.
 // The Login() method must be called before the first usage while it requires authorization token.
-func (c *Client) DeleteChatRoom(shortCode string) error {
+func (c *Client) GetUnreadCount() ([]UnreadCount, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.authToken == "" {
-		return ErrNotLoggedIn
+	if err := c.authorize(); err != nil {
+		return nil, err
 	}
 	if c.conn == nil {
 		if err := c.connect(); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -168,106 +1135,144 @@ func (c *Client) DeleteChatRoom(shortCode string) error {
 		"token": c.authToken,
 	})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	_, err := c.grpcClient.DeleteChatRoom(ctx, &proto.DeleteChatRoomRequest{
-		ShortCode: shortCode,
-	})
+	resp, err := c.grpcClient.GetUnreadCount(ctx, &emptypb.Empty{}, authorizedCall)
 	if err != nil {
-		return fmt.Errorf("failed to delete chat room: %w", err)
+		return nil, fmt.Errorf("failed to get the unread count: %w", err)
 	}
 
-	return nil
-}
+	unreadCounts := make([]UnreadCount, 0, len(resp.GetRooms()))
+	for _, room := range resp.GetRooms() {
+		unreadCounts = append(unreadCounts, UnreadCount{
+			ShortCode:        room.GetShortCode(),
+			RoomName:         room.GetRoomName(),
+			LastReadSequence: room.GetLastReadSequence(),
+			Count:            room.GetUnreadCount(),
+		})
+	}
 
-// JoinChatRoom connects the client to a specific chat room.
-// Returns ErrAlreadyJoined if the client is already connected to a chat room. To leave the current chat room, use the Disconnect method.
-// The Login() method must be called before the first usage.
-func (c *Client) JoinChatRoom(shortCode string, password string) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	return unreadCounts, nil
+}
 
-	if c.authToken == "" {
-		return ErrNotLoggedIn
-	}
-	if c.stream != nil {
-		return ErrAlreadyJoinedChatRoom
-	}
+// EditMessage changes the body of the message with the given ID, previously sent in the currently joined chat room.
+// Only the sender of the message and the owner of the chat room can edit it.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) EditMessage(messageID, body string) error {
+	c.mu.RLock()
 	if c.conn == nil {
-		if err := c.connect(); err != nil {
-			return err
-		}
+		c.mu.RUnlock()
+		return ErrConnectionNotExist
+	}
+	if c.stream == nil {
+		c.mu.RUnlock()
+		return ErrStreamNotExist
 	}
+	c.mu.RUnlock()
 
-	md := metadata.New(map[string]string{
-		"token": c.authToken,
-	})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	resp, err := c.grpcClient.JoinChatRoom(ctx, &proto.JoinChatRoomRequest{
-		ShortCode:    shortCode,
-		RoomPassword: password,
-	})
+	chatToken, err := c.getChatToken()
 	if err != nil {
-		return fmt.Errorf("failed to join the chat room: %w", err)
+		return err
 	}
-	c.chatToken = resp.GetToken()
 
-	md = metadata.New(map[string]string{
-		"token": c.chatToken,
+	md := metadata.New(map[string]string{
+		"token": chatToken,
 	})
-	ctx = metadata.NewOutgoingContext(context.Background(), md)
-	stream, err := c.grpcClient.Chat(ctx)
-	if err != nil {
-		c.conn.Close()
-		return fmt.Errorf("failed to establish a chat stream: %w", err)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	if _, err := c.grpcClient.EditMessage(ctx, &proto.EditMessageRequest{
+		MessageId: messageID,
+		Body:      body,
+	}); err != nil {
+		return fmt.Errorf("failed to edit the message: %w", err)
 	}
-	c.stream = stream
-
-	c.receiveQueue = make(chan Message)
-	c.sendQueue = make(chan string)
-
-	c.closeCh = make(chan struct{})
-
-	c.wg.Add(2)
-	go c.send()
-	go c.receive()
 
 	return nil
 }
 
-// ListChatRoomUsers retrieves the list of users in the currently joined chat room.
+// DeleteMessage deletes the message with the given ID, previously sent in the currently joined chat room.
+// Only the sender of the message and the owner of the chat room can delete it.
 // The JoinChatRoom() method must be called before the first usage.
-func (c *Client) ListChatRoomUsers() ([]string, error) {
+func (c *Client) DeleteMessage(messageID string) error {
 	c.mu.RLock()
 	if c.conn == nil {
 		c.mu.RUnlock()
-		return nil, ErrConnectionNotExist
+		return ErrConnectionNotExist
 	}
 	if c.stream == nil {
 		c.mu.RUnlock()
-		return nil, ErrStreamNotExist
+		return ErrStreamNotExist
 	}
 	c.mu.RUnlock()
 
-	md := metadata.New(map[string]string{
-		"token": c.chatToken,
-	})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	resp, err := c.grpcClient.ListChatRoomUsers(ctx, &emptypb.Empty{})
+	chatToken, err := c.getChatToken()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list the chat room users: %w", err)
+		return err
 	}
 
-	users := make([]string, len(resp.Users))
-	for _, user := range resp.GetUsers() {
-		users = append(users, user.GetUserName())
+	md := metadata.New(map[string]string{
+		"token": chatToken,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	if _, err := c.grpcClient.DeleteMessage(ctx, &proto.DeleteMessageRequest{
+		MessageId: messageID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete the message: %w", err)
 	}
 
-	return users, nil
+	return nil
 }
 
-// Send sends a message to the server.
+// Send sends a message to all users in the chat room.
 // It blocks until the message is sent or returns immediately when an error occured.
 // The JoinChatRoom() method must be called before the first usage.
 func (c *Client) Send(message string) error {
+	return c.sendMessage(&proto.ClientMessage{Body: message})
+}
+
+// SendTo sends a private message to the user with the given name in the chat room.
+// It blocks until the message is sent or returns immediately when an error occured.
+// If the user is not in the chat room, ErrRecipientNotFound is returned by a subsequent call to Receive.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) SendTo(user, message string) error {
+	return c.sendMessage(&proto.ClientMessage{Body: message, Recipient: user})
+}
+
+// SendReply sends a message replying to the message with the given ID to all users in the chat room.
+// It blocks until the message is sent or returns immediately when an error occured.
+// If the replied message does not exist, ErrMessageNotFound is returned by a subsequent call to Receive.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) SendReply(replyTo, message string) error {
+	return c.sendMessage(&proto.ClientMessage{Body: message, ReplyTo: replyTo})
+}
+
+// AddReaction reacts with the emoji to the message with the given ID.
+// Reacting again with the same emoji has no effect.
+// If the message does not exist, ErrMessageNotFound is returned by a subsequent call to Receive.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) AddReaction(messageID, emoji string) error {
+	return c.sendMessage(&proto.ClientMessage{Reaction: &proto.ReactionCommand{MessageId: messageID, Emoji: emoji}})
+}
+
+// RemoveReaction withdraws the reaction with the emoji to the message with the given ID.
+// If the message does not exist, ErrMessageNotFound is returned by a subsequent call to Receive.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) RemoveReaction(messageID, emoji string) error {
+	return c.sendMessage(&proto.ClientMessage{Reaction: &proto.ReactionCommand{MessageId: messageID, Emoji: emoji, Remove: true}})
+}
+
+// MarkRead acknowledges that the client has displayed the messages of the chat room up to the one with the given sequence number.
+// Other users in the chat room receive a ReadReceiptEvent, unless messages up to a greater sequence number have already been marked as read.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) MarkRead(sequence uint64) error {
+	return c.sendMessage(&proto.ClientMessage{MarkRead: &proto.MarkRead{Sequence: sequence}})
+}
+
+// SetTyping notifies other users in the chat room that the client has started or stopped typing.
+// The server stops the typing after a few seconds, so it should be repeated while the user keeps typing.
+// The JoinChatRoom() method must be called before the first usage.
+func (c *Client) SetTyping(typing bool) error {
+	return c.sendMessage(&proto.ClientMessage{Typing: &proto.TypingSignal{Active: typing}})
+}
+
+func (c *Client) sendMessage(message *proto.ClientMessage) error {
 	c.mu.RLock()
 	if c.conn == nil {
 		c.mu.RUnlock()
@@ -288,29 +1293,32 @@ func (c *Client) Send(message string) error {
 	return nil
 }
 
-// Receive receives a message from the server.
-// It blocks until a message arrives or returns immediately when an error occured.
+// Receive receives an event from the server: a Message, MessageEditedEvent, MessageDeletedEvent, ReactionChangedEvent, ReadReceiptEvent, UserJoinedEvent, UserLeftEvent, UserKickedEvent, UserBannedEvent, RoleChangedEvent, OwnerChangedEvent, TypingEvent, RoomUpdatedEvent, RoomDeletedEvent or MissedMessagesEvent.
+// It blocks until an event arrives or returns immediately when an error occured.
+// ErrRecipientNotFound, ErrInvalidRecipient, ErrMessageNotFound, ErrInvalidReaction, ErrInvalidReadSequence and ErrMessageTooLarge refer to a previously sent message, reaction or read acknowledgement; the client can keep receiving events after them.
+// After ErrPermissionDenied the connection with the server is closed.
+// After a RoomDeletedEvent the connection with the server is closed.
 // The JoinChatRoom() method must be called before the first usage.
-func (c *Client) Receive() (Message, error) {
+func (c *Client) Receive() (Event, error) {
 	c.mu.RLock()
 	if c.conn == nil {
 		c.mu.RUnlock()
-		return Message{}, ErrConnectionNotExist
+		return nil, ErrConnectionNotExist
 	}
 	if c.stream == nil {
 		c.mu.RUnlock()
-		return Message{}, ErrStreamNotExist
+		return nil, ErrStreamNotExist
 	}
 	c.mu.RUnlock()
 
 	select {
-	case msg, ok := <-c.receiveQueue:
+	case received, ok := <-c.receiveQueue:
 		if !ok {
-			return msg, ErrConnectionClosed
+			return nil, ErrConnectionClosed
 		}
-		return msg, nil
+		return received.event, received.err
 	case <-c.closeCh:
-		return Message{}, ErrConnectionClosed
+		return nil, ErrConnectionClosed
 	}
 }
 
@@ -322,13 +1330,44 @@ func (c *Client) send() {
 	for {
 		select {
 		case msg := <-c.sendQueue:
-			c.mu.RLock()
-			if err := c.stream.Send(&proto.ClientMessage{Body: msg}); err != nil {
+			for {
+				c.mu.RLock()
+				stream, streamChangedCh := c.stream, c.streamChangedCh
 				c.mu.RUnlock()
-				c.close()
-				return
+
+				if stream == nil {
+					return
+				}
+
+				err := stream.Send(msg)
+				if err == nil {
+					break
+				}
+
+				if c.reconnectPolicy == nil {
+					c.close()
+					return
+				}
+
+				// The receiving goroutine re-establishes the stream, so wait for it and try again.
+				select {
+				case <-streamChangedCh:
+				case <-c.closeCh:
+					return
+				}
 			}
+		case <-c.leaveCh:
+			c.mu.RLock()
+			stream := c.stream
 			c.mu.RUnlock()
+
+			// Closing the sending side tells the server the user has left the chat room, after which it ends the stream.
+			if stream != nil {
+				stream.CloseSend()
+			}
+
+			<-c.closeCh
+			return
 		case <-c.closeCh:
 			return
 		}
@@ -342,60 +1381,222 @@ func (c *Client) receive() {
 	}()
 	for {
 		c.mu.RLock()
-		msg, err := c.stream.Recv()
+		stream, userName := c.stream, c.userName
+		c.mu.RUnlock()
+
+		if stream == nil {
+			return
+		}
+
+		msg, err := stream.Recv()
 		if err != nil {
-			c.mu.RUnlock()
+			select {
+			case <-c.closeCh:
+				return
+			case <-c.leaveCh:
+				c.close()
+				return
+			default:
+			}
+
+			// The server denies the user further access to the chat room, so there is nothing to reconnect to.
+			if status.Code(err) == codes.PermissionDenied {
+				select {
+				case c.receiveQueue <- received{err: fmt.Errorf("%w: %s", ErrPermissionDenied, status.Convert(err).Message())}:
+				case <-c.leaveCh:
+				case <-c.closeCh:
+					return
+				}
+				c.close()
+				return
+			}
+
+			if c.reconnectPolicy == nil || !c.reconnect() {
+				c.close()
+				return
+			}
+
+			continue
+		}
+
+		if sequence := msg.GetMessage().GetSequence(); sequence > c.lastSequence {
+			c.lastSequence = sequence
+		}
+
+		if changesRole(msg, userName) {
+			c.reissueChatToken()
+		}
+
+		select {
+		case c.receiveQueue <- newReceived(msg):
+		// Events sent by the server until it ends the stream of the left chat room are dropped.
+		case <-c.leaveCh:
+		case <-c.closeCh:
+			return
+		}
+
+		// The server ends the stream of a deleted chat room, so there is nothing to reconnect to.
+		if msg.GetRoomDeleted() != nil {
 			c.close()
 			return
 		}
-		c.mu.RUnlock()
+	}
+}
+
+// reconnect tries to re-establish the chat stream according to the reconnect policy.
+// It reports whether the stream has been re-established.
+func (c *Client) reconnect() bool {
+	c.notifyState(StateReconnecting)
 
+	for attempt := 0; attempt < c.reconnectPolicy.MaxAttempts; attempt++ {
 		select {
-		case c.receiveQueue <- Message{
-			Sender: msg.UserName,
-			Body:   msg.Body,
-		}:
+		case <-time.After(c.reconnectPolicy.backoff(attempt)):
 		case <-c.closeCh:
-			return
+			return false
+		}
+
+		err := c.resumeStream()
+		if err == nil {
+			c.notifyState(StateConnected)
+			return true
+		}
+		if isPermanent(err) {
+			return false
 		}
 	}
+
+	return false
+}
+
+// resumeStream opens a new chat stream with the current chat token, asking the server to replay the messages sent after the last received one.
+// It should be called without the c.mu read-write mutex locked.
+func (c *Client) resumeStream() error {
+	// The chat token may have expired while the stream was established, so refresh it first if needed.
+	chatToken, err := c.getChatToken()
+	if err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	grpcClient := c.grpcClient
+	c.mu.RUnlock()
+
+	if grpcClient == nil {
+		return ErrConnectionClosed
+	}
+
+	md := metadata.New(map[string]string{
+		"token":                chatToken,
+		"resume-from-sequence": strconv.FormatUint(c.lastSequence, 10),
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	stream, err := grpcClient.Chat(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The server sends the headers once the stream has been resumed, otherwise it ends the stream with an error.
+	header, err := stream.Header()
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		_, err := stream.Recv()
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.closeCh:
+		return ErrConnectionClosed
+	default:
+	}
+
+	c.stream = stream
+	close(c.streamChangedCh)
+	c.streamChangedCh = make(chan struct{})
+
+	return nil
+}
+
+func (c *Client) notifyState(state ConnectionState) {
+	if c.stateHandler != nil {
+		c.stateHandler(state)
+	}
 }
 
 // It should be called with the c.mu read-write mutex locked.
 func (c *Client) connect() error {
-	conn, err := grpc.Dial(c.grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(c.grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(c.retryUnauthenticated))
 	if err != nil {
 		return fmt.Errorf("failed to connect to server at %s: %w", c.grpcServerAddress, err)
 	}
 
 	c.conn = conn
 	c.grpcClient = proto.NewGRPCChatterClient(conn)
+	c.adminClient = proto.NewGRPCChatterAdminClient(conn)
 
 	return nil
 }
 
 // Disconnect disconnects the client from the server, closing the connection with the server.
+// If the client has joined a chat room, it leaves it first, which revokes its chat token.
 func (c *Client) Disconnect() {
+	c.leave()
 	c.close()
 	c.wg.Wait()
 }
 
+// leave ends the chat stream gracefully and waits for the server to end it, at most for leaveTimeout.
 // It should be called without the c.mu read-write mutex locked.
-func (c *Client) close() {
+func (c *Client) leave() {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	if c.stream == nil {
+		c.mu.Unlock()
+		return
+	}
+	leaveCh, closeCh := c.leaveCh, c.closeCh
 	select {
-	case <-c.closeCh:
+	case <-leaveCh:
 	default:
-		close(c.closeCh)
+		close(leaveCh)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-closeCh:
+	case <-time.After(leaveTimeout):
+	}
+}
+
+// It should be called without the c.mu read-write mutex locked.
+func (c *Client) close() {
+	c.mu.Lock()
+
+	closed := false
+	if c.closeCh != nil {
+		select {
+		case <-c.closeCh:
+		default:
+			close(c.closeCh)
+			closed = true
+		}
 	}
 
 	if c.conn != nil {
 		c.conn.Close()
 	}
 
-	c.conn, c.grpcClient, c.stream, c.authToken, c.chatToken = nil, nil, nil, "", ""
+	c.conn, c.grpcClient, c.adminClient, c.stream, c.authToken, c.chatToken = nil, nil, nil, nil, "", ""
+	c.authTokenExpiresAt, c.refreshToken = time.Time{}, ""
+
+	c.mu.Unlock()
+
+	if closed {
+		c.notifyState(StateDisconnected)
+	}
 }
 
 func (c *Client) postJSON(url string, data any) (*http.Response, error) {
@@ -420,3 +1621,100 @@ func (c *Client) handleErrorResponse(resp *http.Response) error {
 
 	return fmt.Errorf("status: %s error: %s", resp.Status, respErr.Error)
 }
+
+func newReceived(msg *proto.ServerMessage) received {
+	timestamp := msg.GetTimestamp().AsTime()
+
+	switch event := msg.GetEvent().(type) {
+	case *proto.ServerMessage_Message:
+		return received{
+			event: Message{
+				ID:        event.Message.GetId(),
+				Sequence:  event.Message.GetSequence(),
+				Timestamp: timestamp,
+				Sender:    event.Message.GetUserName(),
+				Body:      event.Message.GetBody(),
+				Private:   event.Message.GetPrivate(),
+				EditedAt:  getTime(event.Message.GetEditedAt()),
+				ReplyTo:   event.Message.GetReplyTo(),
+			},
+		}
+	case *proto.ServerMessage_MessageEdited:
+		return received{event: MessageEditedEvent{Timestamp: timestamp, MessageID: event.MessageEdited.GetId(), Body: event.MessageEdited.GetBody()}}
+	case *proto.ServerMessage_MessageDeleted:
+		return received{event: MessageDeletedEvent{Timestamp: timestamp, MessageID: event.MessageDeleted.GetId()}}
+	case *proto.ServerMessage_ReactionChanged:
+		return received{
+			event: ReactionChangedEvent{
+				Timestamp: timestamp,
+				MessageID: event.ReactionChanged.GetMessageId(),
+				Emoji:     event.ReactionChanged.GetEmoji(),
+				UserName:  event.ReactionChanged.GetUserName(),
+				Added:     event.ReactionChanged.GetAdded(),
+				Count:     int(event.ReactionChanged.GetCount()),
+			},
+		}
+	case *proto.ServerMessage_ReadReceipt:
+		return received{event: ReadReceiptEvent{Timestamp: timestamp, UserName: event.ReadReceipt.GetUserName(), Sequence: event.ReadReceipt.GetSequence()}}
+	case *proto.ServerMessage_UserJoined:
+		return received{event: UserJoinedEvent{Timestamp: timestamp, UserName: event.UserJoined.GetUserName()}}
+	case *proto.ServerMessage_UserLeft:
+		return received{event: UserLeftEvent{Timestamp: timestamp, UserName: event.UserLeft.GetUserName()}}
+	case *proto.ServerMessage_UserTyping:
+		return received{event: TypingEvent{Timestamp: timestamp, UserName: event.UserTyping.GetUserName(), Typing: event.UserTyping.GetActive()}}
+	case *proto.ServerMessage_UserKicked:
+		return received{event: UserKickedEvent{Timestamp: timestamp, UserName: event.UserKicked.GetUserName()}}
+	case *proto.ServerMessage_UserBanned:
+		return received{event: UserBannedEvent{Timestamp: timestamp, UserName: event.UserBanned.GetUserName()}}
+	case *proto.ServerMessage_OwnerChanged:
+		return received{event: OwnerChangedEvent{Timestamp: timestamp, UserName: event.OwnerChanged.GetUserName()}}
+	case *proto.ServerMessage_RoleChanged:
+		return received{event: RoleChangedEvent{Timestamp: timestamp, UserName: event.RoleChanged.GetUserName(), Role: RoomRole(event.RoleChanged.GetRole())}}
+	case *proto.ServerMessage_RoomUpdated:
+		return received{event: RoomUpdatedEvent{Timestamp: timestamp, Name: event.RoomUpdated.GetName(), Description: event.RoomUpdated.GetDescription()}}
+	case *proto.ServerMessage_RoomDeleted:
+		return received{event: RoomDeletedEvent{Timestamp: timestamp}}
+	case *proto.ServerMessage_MissedMessages:
+		return received{event: MissedMessagesEvent{Timestamp: timestamp, Count: event.MissedMessages.GetCount()}}
+	case *proto.ServerMessage_Announcement:
+		return received{event: AnnouncementEvent{Timestamp: timestamp, Sender: event.Announcement.GetUserName(), Body: event.Announcement.GetBody()}}
+	case *proto.ServerMessage_Error:
+		switch event.Error.GetCode() {
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_RECIPIENT_NOT_FOUND:
+			return received{err: fmt.Errorf("%w: %s", ErrRecipientNotFound, event.Error.GetMessage())}
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_RECIPIENT:
+			return received{err: fmt.Errorf("%w: %s", ErrInvalidRecipient, event.Error.GetMessage())}
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_MESSAGE_NOT_FOUND:
+			return received{err: fmt.Errorf("%w: %s", ErrMessageNotFound, event.Error.GetMessage())}
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_REACTION:
+			return received{err: fmt.Errorf("%w: %s", ErrInvalidReaction, event.Error.GetMessage())}
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_INVALID_READ_SEQUENCE:
+			return received{err: fmt.Errorf("%w: %s", ErrInvalidReadSequence, event.Error.GetMessage())}
+		case proto.ChatErrorCode_CHAT_ERROR_CODE_MESSAGE_TOO_LARGE:
+			return received{err: fmt.Errorf("%w: %s", ErrMessageTooLarge, event.Error.GetMessage())}
+		default:
+			return received{err: errors.New(event.Error.GetMessage())}
+		}
+	default:
+		return received{err: errors.New("unknown event received from the server")}
+	}
+}
+
+// changesRole reports whether the message changes the role of the user in the chat room.
+// An ownership transfer does not name the previous owner, so it is considered to change the role of every user.
+func changesRole(msg *proto.ServerMessage, userName string) bool {
+	if roleChanged := msg.GetRoleChanged(); roleChanged != nil {
+		return roleChanged.GetUserName() == userName
+	}
+
+	return msg.GetOwnerChanged() != nil
+}
+
+// getTime converts the optional timestamp into time, returning zero time if it is not set.
+func getTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
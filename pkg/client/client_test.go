@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/internal/dto"
+	"github.com/MSSkowron/GRPCChatter/proto/gen/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stubServer accepts only the current authorization token, as the server does once the previous one has expired.
+type stubServer struct {
+	proto.UnimplementedGRPCChatterServer
+	token atomic.Value
+}
+
+func (s *stubServer) ListChatRooms(ctx context.Context, req *proto.ListChatRoomsRequest) (*proto.ListChatRoomsResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get("token"); len(tokens) == 0 || tokens[0] != s.token.Load().(string) {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return &proto.ListChatRoomsResponse{Rooms: []*proto.ChatRoom{{ShortCode: "ABC123"}}}, nil
+}
+
+func TestRetryUnauthenticated(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	stub := &stubServer{}
+	stub.token.Store("token2")
+	proto.RegisterGRPCChatterServer(grpcServer, stub)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(ln)
+	t.Cleanup(grpcServer.Stop)
+
+	var refreshes atomic.Int32
+	restServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/refresh", r.URL.Path)
+		refreshes.Add(1)
+
+		require.NoError(t, json.NewEncoder(w).Encode(dto.TokenDTO{
+			Token:        "token2",
+			ExpiresAt:    time.Now().Add(time.Hour),
+			RefreshToken: "refresh2",
+		}))
+	}))
+	t.Cleanup(restServer.Close)
+
+	// The client considers its token valid for another hour, so it does not refresh it before the call, while the server has already rejected it.
+	c := NewClient(strings.TrimPrefix(restServer.URL, "http://"), ln.Addr().String())
+	c.authToken, c.authTokenExpiresAt, c.refreshToken = "token1", time.Now().Add(time.Hour), "refresh1"
+	t.Cleanup(c.Disconnect)
+
+	rooms, _, err := c.ListChatRooms("", "", 0)
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	require.Equal(t, int32(1), refreshes.Load())
+	require.Equal(t, "refresh2", c.refreshToken)
+
+	// A token rejected again after the refresh fails the call without further attempts.
+	stub.token.Store("token3")
+	_, _, err = c.ListChatRooms("", "", 0)
+	require.Equal(t, codes.Unauthenticated, status.Code(errors.Unwrap(err)))
+	require.Equal(t, int32(2), refreshes.Load())
+}
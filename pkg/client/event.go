@@ -0,0 +1,128 @@
+package client
+
+import "time"
+
+// Event represents an event received in a chat room.
+// It is one of Message, MessageEditedEvent, MessageDeletedEvent, ReactionChangedEvent, ReadReceiptEvent, UserJoinedEvent, UserLeftEvent, UserKickedEvent, UserBannedEvent, RoleChangedEvent, OwnerChangedEvent, TypingEvent, RoomUpdatedEvent, RoomDeletedEvent, MissedMessagesEvent and AnnouncementEvent.
+type Event interface {
+	isEvent()
+}
+
+// MessageEditedEvent means a message sent in the chat room has been edited.
+type MessageEditedEvent struct {
+	Timestamp time.Time // Timestamp is the time the message was edited at.
+	MessageID string    // MessageID is the ID of the edited message, the same as Message.ID.
+	Body      string    // Body is the new content of the message.
+}
+
+// MessageDeletedEvent means a message sent in the chat room has been deleted.
+type MessageDeletedEvent struct {
+	Timestamp time.Time // Timestamp is the time the message was deleted at.
+	MessageID string    // MessageID is the ID of the deleted message, the same as Message.ID.
+}
+
+// ReactionChangedEvent means a user has reacted to a message sent in the chat room or withdrawn their reaction.
+type ReactionChangedEvent struct {
+	Timestamp time.Time // Timestamp is the time the reaction changed at.
+	MessageID string    // MessageID is the ID of the message, the same as Message.ID.
+	Emoji     string    // Emoji is the reaction.
+	UserName  string    // UserName is the name of the user who reacted.
+	Added     bool      // Added is true if the user has reacted and false if they have withdrawn their reaction.
+	Count     int       // Count is the number of users who have reacted to the message with the emoji.
+}
+
+// ReadReceiptEvent means a user has read the messages of the chat room up to a sequence number.
+type ReadReceiptEvent struct {
+	Timestamp time.Time // Timestamp is the time the messages were marked as read at.
+	UserName  string    // UserName is the name of the user who read the messages.
+	Sequence  uint64    // Sequence is the sequence number of the last message read by the user, the same as Message.Sequence.
+}
+
+// UserJoinedEvent means a user has joined the chat room.
+type UserJoinedEvent struct {
+	Timestamp time.Time // Timestamp is the time the user joined at.
+	UserName  string    // UserName is the name of the user who joined.
+}
+
+// UserLeftEvent means a user has left the chat room.
+type UserLeftEvent struct {
+	Timestamp time.Time // Timestamp is the time the user left at.
+	UserName  string    // UserName is the name of the user who left.
+}
+
+// UserKickedEvent means a user has been kicked from the chat room by its owner or a moderator.
+// If the kicked user is the client's user, the connection with the server is closed afterwards.
+type UserKickedEvent struct {
+	Timestamp time.Time // Timestamp is the time the user was kicked at.
+	UserName  string    // UserName is the name of the kicked user.
+}
+
+// UserBannedEvent means a user has been banned from the chat room by its owner.
+// If the banned user is the client's user, the connection with the server is closed afterwards.
+type UserBannedEvent struct {
+	Timestamp time.Time // Timestamp is the time the user was banned at.
+	UserName  string    // UserName is the name of the banned user.
+}
+
+// RoleChangedEvent means the role of a user in the chat room has been changed.
+type RoleChangedEvent struct {
+	Timestamp time.Time // Timestamp is the time the role was changed at.
+	UserName  string    // UserName is the name of the user whose role was changed.
+	Role      RoomRole  // Role is the new role of the user.
+}
+
+// OwnerChangedEvent means the ownership of the chat room has been transferred to a user, by its previous owner or because they left it.
+type OwnerChangedEvent struct {
+	Timestamp time.Time // Timestamp is the time the ownership was transferred at.
+	UserName  string    // UserName is the name of the new owner.
+}
+
+// TypingEvent means a user has started or stopped typing in the chat room.
+// The server stops the typing after a few seconds, unless the user keeps signalling it.
+type TypingEvent struct {
+	Timestamp time.Time // Timestamp is the time the typing started or stopped at.
+	UserName  string    // UserName is the name of the typing user.
+	Typing    bool      // Typing is true if the user has started typing and false if they have stopped.
+}
+
+// RoomUpdatedEvent means the chat room has been updated by its owner.
+type RoomUpdatedEvent struct {
+	Timestamp   time.Time // Timestamp is the time the chat room was updated at.
+	Name        string    // Name is the name of the chat room after the update.
+	Description string    // Description is the description or topic of the chat room after the update.
+}
+
+// RoomDeletedEvent means the chat room has been deleted by its owner. It is the last event received in the chat room.
+type RoomDeletedEvent struct {
+	Timestamp time.Time // Timestamp is the time the chat room was deleted at.
+}
+
+// MissedMessagesEvent means events have been dropped because the client did not keep up with the chat room.
+type MissedMessagesEvent struct {
+	Timestamp time.Time // Timestamp is the time the client was notified at.
+	Count     uint64    // Count is the number of dropped events.
+}
+
+// AnnouncementEvent means an admin has made a server-wide announcement to users in all chat rooms.
+type AnnouncementEvent struct {
+	Timestamp time.Time // Timestamp is the time the announcement was made at.
+	Sender    string    // Sender is the name of the admin who made the announcement.
+	Body      string    // Body is the content of the announcement.
+}
+
+func (Message) isEvent()              {}
+func (MessageEditedEvent) isEvent()   {}
+func (MessageDeletedEvent) isEvent()  {}
+func (ReactionChangedEvent) isEvent() {}
+func (ReadReceiptEvent) isEvent()     {}
+func (UserJoinedEvent) isEvent()      {}
+func (UserLeftEvent) isEvent()        {}
+func (UserKickedEvent) isEvent()      {}
+func (UserBannedEvent) isEvent()      {}
+func (RoleChangedEvent) isEvent()     {}
+func (OwnerChangedEvent) isEvent()    {}
+func (TypingEvent) isEvent()          {}
+func (RoomUpdatedEvent) isEvent()     {}
+func (RoomDeletedEvent) isEvent()     {}
+func (MissedMessagesEvent) isEvent()  {}
+func (AnnouncementEvent) isEvent()    {}
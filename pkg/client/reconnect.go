@@ -0,0 +1,73 @@
+package client
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConnectionState represents the state of the client's chat stream.
+type ConnectionState int
+
+const (
+	// StateConnected means the chat stream is established.
+	StateConnected ConnectionState = iota
+	// StateReconnecting means the chat stream has been interrupted and the client is trying to re-establish it.
+	StateReconnecting
+	// StateDisconnected means the chat stream has been closed and will not be re-established.
+	StateDisconnected
+)
+
+// String returns the name of the connection state.
+func (s ConnectionState) String() string {
+	switch s {
+	case StateConnected:
+		return "CONNECTED"
+	case StateReconnecting:
+		return "RECONNECTING"
+	case StateDisconnected:
+		return "DISCONNECTED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ReconnectPolicy configures how the client re-establishes an interrupted chat stream.
+// The delay before the n-th attempt is InitialBackoff * Multiplier^n, capped at MaxBackoff and randomized by Jitter.
+type ReconnectPolicy struct {
+	MaxAttempts    int           // MaxAttempts is the maximum number of consecutive reconnection attempts before giving up.
+	InitialBackoff time.Duration // InitialBackoff is the delay before the first reconnection attempt.
+	MaxBackoff     time.Duration // MaxBackoff is the upper bound of the delay between reconnection attempts.
+	Multiplier     float64       // Multiplier is the factor the delay is multiplied by after each failed attempt.
+	Jitter         float64       // Jitter is the fraction, between 0 and 1, by which the delay is randomly increased or decreased.
+}
+
+// DefaultReconnectPolicy is a reconnect policy suitable for most clients.
+var DefaultReconnectPolicy = ReconnectPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// backoff returns the delay before the given reconnection attempt, counting from 0.
+func (p ReconnectPolicy) backoff(attempt int) time.Duration {
+	delay := math.Min(float64(p.InitialBackoff)*math.Pow(p.Multiplier, float64(attempt)), float64(p.MaxBackoff))
+	delay *= 1 + p.Jitter*(2*rand.Float64()-1)
+
+	return time.Duration(math.Max(delay, 0))
+}
+
+// isPermanent reports whether the error returned while re-establishing the chat stream makes further attempts pointless.
+func isPermanent(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied, codes.Unauthenticated, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
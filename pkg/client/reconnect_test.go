@@ -0,0 +1,47 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := ReconnectPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	require.Equal(t, 100*time.Millisecond, policy.backoff(0))
+	require.Equal(t, 200*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 800*time.Millisecond, policy.backoff(3))
+	require.Equal(t, time.Second, policy.backoff(10))
+}
+
+func TestBackoffJitter(t *testing.T) {
+	policy := ReconnectPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1)
+		require.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		require.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+}
+
+func TestIsPermanent(t *testing.T) {
+	require.True(t, isPermanent(status.Error(codes.NotFound, "room not found")))
+	require.True(t, isPermanent(status.Error(codes.PermissionDenied, "no permission")))
+	require.False(t, isPermanent(status.Error(codes.Unavailable, "unavailable")))
+	require.False(t, isPermanent(status.Error(codes.AlreadyExists, "still connected")))
+	require.False(t, isPermanent(errors.New("EOF")))
+}
@@ -1,7 +1,12 @@
 package crypto
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -34,3 +39,20 @@ func CheckPassword(password, hash string) error {
 
 	return nil
 }
+
+// GenerateToken generates a random, URL-safe token from the given number of random bytes.
+func GenerateToken(size int) (string, error) {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// HashToken hashes a token with SHA-256.
+// Unlike passwords, randomly generated tokens are hard enough to guess without a slow hash, so they can be looked up by their hashes.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
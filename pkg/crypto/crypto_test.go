@@ -47,3 +47,18 @@ func TestCrypto(t *testing.T) {
 		})
 	}
 }
+
+func TestToken(t *testing.T) {
+	token, err := GenerateToken(32)
+	require.NoError(t, err)
+	require.Len(t, token, 43)
+
+	otherToken, err := GenerateToken(32)
+	require.NoError(t, err)
+	require.NotEqual(t, token, otherToken)
+
+	hash := HashToken(token)
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, HashToken(token))
+	require.NotEqual(t, hash, HashToken(otherToken))
+}
@@ -0,0 +1,304 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// DiscoveryPath is the path of the OpenID Provider configuration document, relative to the issuer URL.
+	DiscoveryPath = "/.well-known/openid-configuration"
+
+	// ScopeOpenID is the scope requesting an ID token, which every authentication request must include.
+	ScopeOpenID = "openid"
+
+	// maxResponseSize is the maximum size of a response read from the OpenID Provider.
+	maxResponseSize = 1 << 20
+)
+
+var (
+	// DefaultScopes are the scopes requested when the client is not configured with other ones.
+	DefaultScopes = []string{ScopeOpenID, "profile", "email"}
+
+	// ErrInvalidProvider is returned when the OpenID Provider configuration is invalid.
+	ErrInvalidProvider = errors.New("invalid OpenID Provider configuration")
+	// ErrExchangeFailed is returned when the OpenID Provider refuses to exchange the authorization code for tokens.
+	ErrExchangeFailed = errors.New("authorization code exchange failed")
+	// ErrInvalidIDToken is returned when the ID token is invalid, expired or not intended for the client.
+	ErrInvalidIDToken = errors.New("invalid ID token")
+)
+
+// Provider holds the configuration of an OpenID Provider, as published by its discovery document.
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Discover fetches the configuration of the OpenID Provider identified by the issuer URL from its discovery document.
+func Discover(ctx context.Context, issuerURL string, httpClient *http.Client) (*Provider, error) {
+	provider := &Provider{}
+	if err := getJSON(ctx, httpClient, strings.TrimSuffix(issuerURL, "/")+DiscoveryPath, provider); err != nil {
+		return nil, fmt.Errorf("failed to discover OpenID Provider: %w", err)
+	}
+
+	// The issuer must match the URL the configuration has been fetched from, so that one provider cannot impersonate another.
+	if provider.Issuer != issuerURL {
+		return nil, fmt.Errorf("%w: issuer %s does not match %s", ErrInvalidProvider, provider.Issuer, issuerURL)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: missing endpoints", ErrInvalidProvider)
+	}
+
+	return provider, nil
+}
+
+// IDToken holds the claims of a verified ID token that identify the authenticated user.
+type IDToken struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	PreferredUsername string
+	Name              string
+}
+
+// Client is an OpenID Connect relying party, which authenticates users with an OpenID Provider using the authorization code flow with PKCE.
+type Client struct {
+	provider     *Provider
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu sync.Mutex
+	// keySet holds the keys of the provider, fetched the first time they are needed.
+	keySet *token.KeySet
+}
+
+// NewClient creates a new Client of the provider with the provided clientID, clientSecret and redirectURL the provider redirects users back to.
+func NewClient(provider *Provider, clientID, clientSecret, redirectURL string, opts ...ClientOption) *Client {
+	client := &Client{
+		provider:     provider,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       DefaultScopes,
+		httpClient:   http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// ClientOption is a function signature for providing options to configure the Client.
+type ClientOption func(*Client)
+
+// WithScopes is an option to set the scopes requested from the provider. The openid scope is always requested.
+func WithScopes(scopes ...string) ClientOption {
+	return func(c *Client) {
+		c.scopes = []string{ScopeOpenID}
+		for _, scope := range scopes {
+			if scope != ScopeOpenID {
+				c.scopes = append(c.scopes, scope)
+			}
+		}
+	}
+}
+
+// WithHTTPClient is an option to set the HTTP client the provider is requested with.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// RedirectURL returns the URL the provider redirects users back to once they are authenticated.
+func (c *Client) RedirectURL() string {
+	return c.redirectURL
+}
+
+// AuthCodeURL returns the URL of the provider the user is redirected to in order to authenticate.
+// The provider passes the state back to the redirect URL and puts the nonce into the ID token, while the code verifier must be presented when the authorization code is exchanged.
+func (c *Client) AuthCodeURL(state, nonce, codeVerifier string) string {
+	codeChallenge := sha256.Sum256([]byte(codeVerifier))
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.clientID},
+		"redirect_uri":          {c.redirectURL},
+		"scope":                 {strings.Join(c.scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(codeChallenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(c.provider.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return c.provider.AuthorizationEndpoint + separator + query.Encode()
+}
+
+// Exchange exchanges the authorization code for tokens and returns the verified ID token, which must carry the nonce.
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*IDToken, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.redirectURL},
+		"code_verifier": {codeVerifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.provider.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request tokens: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	var tokenResponse struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil && res.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d: %s %s", ErrExchangeFailed, res.StatusCode, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+	if tokenResponse.IDToken == "" {
+		return nil, fmt.Errorf("%w: no ID token", ErrExchangeFailed)
+	}
+
+	return c.verify(ctx, tokenResponse.IDToken, nonce)
+}
+
+// verify verifies the signature and claims of the ID token with the keys of the provider.
+func (c *Client) verify(ctx context.Context, rawIDToken, nonce string) (*IDToken, error) {
+	keySet, err := c.getKeySet(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := token.Parse(rawIDToken, keySet)
+	if err != nil {
+		var validationErr *jwt.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Errors&jwt.ValidationErrorUnverifiable == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+		}
+
+		// The token may be signed with a key the provider has rotated in since its keys were fetched.
+		if keySet, err = c.getKeySet(ctx, true); err != nil {
+			return nil, err
+		}
+		if parsed, err = token.Parse(rawIDToken, keySet); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+		}
+	}
+
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok || !parsed.Valid {
+		return nil, ErrInvalidIDToken
+	}
+
+	idToken := &IDToken{}
+	idToken.Issuer, _ = claims["iss"].(string)
+	idToken.Subject, _ = claims["sub"].(string)
+	idToken.Email, _ = claims["email"].(string)
+	idToken.PreferredUsername, _ = claims["preferred_username"].(string)
+	idToken.Name, _ = claims["name"].(string)
+
+	if idToken.Issuer != c.provider.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidIDToken, idToken.Issuer)
+	}
+	if !token.HasAudience(claims, c.clientID) {
+		return nil, fmt.Errorf("%w: not issued for the client", ErrInvalidIDToken)
+	}
+	if idToken.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+	// Parse checks the expiration time only if the token carries it.
+	if _, ok := claims["exp"].(float64); !ok {
+		return nil, fmt.Errorf("%w: no expiration time", ErrInvalidIDToken)
+	}
+	// The nonce ties the ID token to the authentication request, so that a token issued for another request cannot be replayed.
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return idToken, nil
+}
+
+// getKeySet returns the keys of the provider, fetching them if they have not been fetched yet or refresh is set.
+func (c *Client) getKeySet(ctx context.Context, refresh bool) (*token.KeySet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keySet != nil && !refresh {
+		return c.keySet, nil
+	}
+
+	jwks := &token.JWKS{}
+	if err := getJSON(ctx, c.httpClient, c.provider.JWKSURI, jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenID Provider keys: %w", err)
+	}
+
+	keySet, err := token.ParseJWKS(jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenID Provider keys: %w", err)
+	}
+	c.keySet = keySet
+
+	return keySet, nil
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, url)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v)
+}
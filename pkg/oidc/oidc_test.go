@@ -0,0 +1,251 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "grpcchatter"
+	testClientSecret = "secret"
+	testRedirectURL  = "http://localhost:8080/auth/oidc/callback"
+	testCode         = "code123"
+	testCodeVerifier = "verifier123"
+	testNonce        = "nonce123"
+	testSubject      = "248289761001"
+)
+
+// stubProvider is a minimal OpenID Provider, which issues an ID token with the claims for the test code.
+type stubProvider struct {
+	*httptest.Server
+	t      *testing.T
+	keyDir string
+
+	mu     sync.Mutex
+	keySet *token.KeySet
+	claims jwt.MapClaims
+}
+
+func newStubProvider(t *testing.T) *stubProvider {
+	p := &stubProvider{
+		t:      t,
+		keyDir: t.TempDir(),
+	}
+	p.rotateKey("key1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		p.respondWithJSON(w, http.StatusOK, &Provider{
+			Issuer:                p.URL,
+			AuthorizationEndpoint: p.URL + "/authorize",
+			TokenEndpoint:         p.URL + "/token",
+			JWKSURI:               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		jwks, err := token.NewJWKS(p.keySet)
+		require.NoError(p.t, err)
+
+		p.respondWithJSON(w, http.StatusOK, jwks)
+	})
+	mux.HandleFunc("/token", p.handleToken)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	p.claims = jwt.MapClaims{
+		"iss":                p.URL,
+		"aud":                testClientID,
+		"sub":                testSubject,
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"nonce":              testNonce,
+		"email":              "jane.doe@example.com",
+		"preferred_username": "jane.doe",
+	}
+
+	return p
+}
+
+func (p *stubProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok || clientID != testClientID || clientSecret != testClientSecret {
+		p.respondWithJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	codeChallenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if r.PostFormValue("grant_type") != "authorization_code" || r.PostFormValue("code") != testCode || r.PostFormValue("redirect_uri") != testRedirectURL ||
+		base64.RawURLEncoding.EncodeToString(codeChallenge[:]) != testCodeChallenge() {
+		p.respondWithJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	idToken, err := token.NewWithClaims(&p.claims, p.keySet)
+	require.NoError(p.t, err)
+
+	p.respondWithJSON(w, http.StatusOK, map[string]string{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+// rotateKey makes the provider sign ID tokens with a new key.
+func (p *stubProvider) rotateKey(id string) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(p.t, err)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+	require.NoError(p.t, os.WriteFile(filepath.Join(p.keyDir, id+token.KeyFileExtension), data, 0o600))
+
+	keySet, err := token.LoadKeySet(p.keyDir, id)
+	require.NoError(p.t, err)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keySet = keySet
+}
+
+func (p *stubProvider) setClaim(key string, value any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.claims[key] = value
+}
+
+func (p *stubProvider) respondWithJSON(w http.ResponseWriter, code int, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	require.NoError(p.t, json.NewEncoder(w).Encode(payload))
+}
+
+func testCodeChallenge() string {
+	codeChallenge := sha256.Sum256([]byte(testCodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(codeChallenge[:])
+}
+
+func newTestClient(t *testing.T, p *stubProvider) *Client {
+	provider, err := Discover(context.Background(), p.URL, http.DefaultClient)
+	require.NoError(t, err)
+
+	return NewClient(provider, testClientID, testClientSecret, testRedirectURL)
+}
+
+func TestDiscover(t *testing.T) {
+	p := newStubProvider(t)
+
+	provider, err := Discover(context.Background(), p.URL, http.DefaultClient)
+	require.NoError(t, err)
+	require.Equal(t, p.URL, provider.Issuer)
+	require.Equal(t, p.URL+"/token", provider.TokenEndpoint)
+
+	// The issuer must match the URL the configuration has been fetched from.
+	_, err = Discover(context.Background(), p.URL+"/", http.DefaultClient)
+	require.ErrorIs(t, err, ErrInvalidProvider)
+
+	_, err = Discover(context.Background(), p.URL+"/missing", http.DefaultClient)
+	require.Error(t, err)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	client := newTestClient(t, newStubProvider(t))
+
+	authCodeURL, err := url.Parse(client.AuthCodeURL("state123", testNonce, testCodeVerifier))
+	require.NoError(t, err)
+
+	query := authCodeURL.Query()
+	require.Equal(t, "/authorize", authCodeURL.Path)
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, testClientID, query.Get("client_id"))
+	require.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	require.Equal(t, "openid profile email", query.Get("scope"))
+	require.Equal(t, "state123", query.Get("state"))
+	require.Equal(t, testNonce, query.Get("nonce"))
+	require.Equal(t, testCodeChallenge(), query.Get("code_challenge"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+func TestExchange(t *testing.T) {
+	p := newStubProvider(t)
+	client := newTestClient(t, p)
+
+	idToken, err := client.Exchange(context.Background(), testCode, testCodeVerifier, testNonce)
+	require.NoError(t, err)
+	require.Equal(t, p.URL, idToken.Issuer)
+	require.Equal(t, testSubject, idToken.Subject)
+	require.Equal(t, "jane.doe@example.com", idToken.Email)
+	require.Equal(t, "jane.doe", idToken.PreferredUsername)
+
+	// ID tokens signed with a rotated key are verified with the refetched keys.
+	p.rotateKey("key2")
+	_, err = client.Exchange(context.Background(), testCode, testCodeVerifier, testNonce)
+	require.NoError(t, err)
+
+	// The provider refuses an invalid code or code verifier.
+	_, err = client.Exchange(context.Background(), "invalid", testCodeVerifier, testNonce)
+	require.ErrorIs(t, err, ErrExchangeFailed)
+	_, err = client.Exchange(context.Background(), testCode, "invalid", testNonce)
+	require.ErrorIs(t, err, ErrExchangeFailed)
+
+	_, err = NewClient(client.provider, testClientID, "invalid", testRedirectURL).Exchange(context.Background(), testCode, testCodeVerifier, testNonce)
+	require.ErrorIs(t, err, ErrExchangeFailed)
+}
+
+func TestExchangeInvalidIDToken(t *testing.T) {
+	data := []struct {
+		name  string
+		key   string
+		value any
+		nonce string
+	}{
+		{"Nonce mismatch", "nonce", testNonce, "other"},
+		{"Other audience", "aud", "other", testNonce},
+		{"Other issuer", "iss", "https://other.example.com", testNonce},
+		{"Expired", "exp", time.Now().Add(-time.Minute).Unix(), testNonce},
+		{"No subject", "sub", "", testNonce},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			p := newStubProvider(t)
+			client := newTestClient(t, p)
+
+			p.setClaim(d.key, d.value)
+
+			_, err := client.Exchange(context.Background(), testCode, testCodeVerifier, d.nonce)
+			require.ErrorIs(t, err, ErrInvalidIDToken)
+		})
+	}
+
+	// An audience list must contain the client.
+	p := newStubProvider(t)
+	client := newTestClient(t, p)
+
+	p.setClaim("aud", []string{"other", testClientID})
+	_, err := client.Exchange(context.Background(), testCode, testCodeVerifier, testNonce)
+	require.NoError(t, err)
+}
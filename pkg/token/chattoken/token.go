@@ -1,72 +1,79 @@
 package chattoken
 
 import (
-	"errors"
+	"time"
 
 	"github.com/MSSkowron/GRPCChatter/pkg/token"
-	"github.com/golang-jwt/jwt"
 )
 
+// TokenType is the type of chat tokens, carried in the token type claim.
+const TokenType = "chat"
+
 const (
-	// ClaimUserNameKey is the key for user name claim.
-	ClaimUserNameKey = "userName"
+	// ClaimUserNameKey is the key for user name claim, which is the subject of the token.
+	ClaimUserNameKey = token.ClaimSubjectKey
 	// ClaimShortCodeKey is the key for short code claim.
 	ClaimShortCodeKey = "shortCode"
+	// ClaimRoleKey is the key for the claim with the user's role in the chat room at the time the token was generated.
+	// The claim is informational only, as the role may change while the token is valid, so it must not be used for authorization.
+	ClaimRoleKey = "role"
+	// ClaimIssuedAtKey is the key for issue time claim, in Unix seconds with microsecond precision.
+	ClaimIssuedAtKey = token.ClaimIssuedAtKey
+	// ClaimExpiresAtKey is the key for expiration time claim, in Unix seconds.
+	ClaimExpiresAtKey = token.ClaimExpiresAtKey
 )
 
-// ErrInvalidToken is returned when the token is invalid.
-var ErrInvalidToken = errors.New("invalid token")
+var (
+	// ErrInvalidToken is returned when the token is invalid.
+	ErrInvalidToken = token.ErrInvalidToken
+	// ErrExpiredToken is returned when the token is expired.
+	ErrExpiredToken = token.ErrExpiredToken
+)
 
-// Generate generates a new JWT token with user name and short code.
-func Generate(userName, shortCode, secret string) (string, error) {
-	claims := &jwt.MapClaims{
-		ClaimUserNameKey:  userName,
-		ClaimShortCodeKey: shortCode,
-	}
+// Generate generates a new JWT token with the standard claims, user name, short code, the user's role in the chat room, issue time and expiration time, issued by the issuer.
+func Generate(userName, shortCode, role string, expirationTime time.Duration, issuer *token.Issuer) (string, error) {
+	claims := issuer.NewClaims(TokenType, userName, expirationTime)
+	claims[ClaimShortCodeKey] = shortCode
+	claims[ClaimRoleKey] = role
 
-	return token.NewWithClaims(claims, secret)
+	return issuer.Sign(claims)
 }
 
-// Validate validates the given JWT token.
-func Validate(tokenString, secret string) error {
-	token, err := token.Parse(tokenString, secret)
-	if err != nil || !token.Valid {
-		return ErrInvalidToken
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return ErrInvalidToken
+// Validate validates the given JWT token, which must be a chat token issued by the issuer.
+func Validate(tokenString string, issuer *token.Issuer) error {
+	claims, err := issuer.ParseClaims(tokenString, TokenType)
+	if err != nil {
+		return err
 	}
 
-	_, ok = claims[ClaimUserNameKey].(string)
-	if !ok {
+	if _, ok := claims[ClaimShortCodeKey].(string); !ok {
 		return ErrInvalidToken
 	}
 
-	_, ok = claims[ClaimShortCodeKey].(string)
-	if !ok {
+	if _, ok := claims[ClaimRoleKey].(string); !ok {
 		return ErrInvalidToken
 	}
 
 	return nil
 }
 
-// GetClaim retrieves a claim value with the given key from the given JWT token.
-func GetClaim(tokenString, secret, key string) (string, error) {
-	token, err := token.Parse(tokenString, secret)
-	if err != nil || !token.Valid {
-		return "", ErrInvalidToken
-	}
+// Claim is a generic type for JWT claims.
+type Claim interface {
+	string | float64
+}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return "", ErrInvalidToken
+// GetClaim retrieves a claim value with the given key from the given JWT token, which must be a chat token issued by the issuer.
+func GetClaim[T Claim](tokenString string, issuer *token.Issuer, key string) (T, error) {
+	var value T
+
+	claims, err := issuer.ParseClaims(tokenString, TokenType)
+	if err != nil {
+		return value, err
 	}
 
-	value, ok := claims[key].(string)
+	value, ok := claims[key].(T)
 	if !ok {
-		return "", ErrInvalidToken
+		return value, ErrInvalidToken
 	}
 
 	return value, nil
@@ -2,75 +2,128 @@ package chattoken
 
 import (
 	"testing"
+	"time"
 
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	testSecret    = "testsecret123"
-	testUserName  = "MSSkowron"
-	testShortCode = "ABC123"
+	testIssuerName = "grpcchatter"
+	testAudience   = "grpcchatter-test"
+	testSecret     = "testsecret123"
+	testUserName   = "MSSkowron"
+	testShortCode  = "ABC123"
+	testRole       = "MEMBER"
+
+	testExpirationTime = 10 * time.Second
 )
 
+var testIssuer = newTestIssuer(testSecret)
+
+func newTestIssuer(secret string) *token.Issuer {
+	return token.NewIssuer(testIssuerName, testAudience, token.NewHMACKeySet(secret))
+}
+
 func TestGenerate(t *testing.T) {
-	tokenString, err := Generate(testUserName, testShortCode, testSecret)
+	tokenString, err := Generate(testUserName, testShortCode, testRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 	require.NotEmpty(t, tokenString)
 }
 
 func TestValidate(t *testing.T) {
 	// Valid token
-	tokenString, err := Generate(testUserName, testShortCode, testSecret)
+	tokenString, err := Generate(testUserName, testShortCode, testRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	err = Validate(tokenString, testSecret)
+	err = Validate(tokenString, testIssuer)
 	require.NoError(t, err)
 
 	// Invalid token
-	err = Validate("invalidtoken", testSecret)
+	err = Validate("invalidtoken", testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
 
 	// Token with incorrect secret
 	invalidSecret := "invalidsecret321"
-	tokenString, err = Generate(testUserName, testShortCode, testSecret)
+	tokenString, err = Generate(testUserName, testShortCode, testRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	err = Validate(tokenString, invalidSecret)
+	err = Validate(tokenString, newTestIssuer(invalidSecret))
 	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Expired token
+	tokenString, err = Generate(testUserName, testShortCode, testRole, -time.Minute, testIssuer)
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrExpiredToken)
 }
 
 func TestGetClaim(t *testing.T) {
 	// Valid claim retrieval
-	tokenString, err := Generate(testUserName, testShortCode, testSecret)
+	tokenString, err := Generate(testUserName, testShortCode, testRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	userName, err := GetClaim(tokenString, testSecret, ClaimUserNameKey)
+	userName, err := GetClaim[string](tokenString, testIssuer, ClaimUserNameKey)
 	require.NoError(t, err)
 	require.Equal(t, testUserName, userName)
 
-	shortCode, err := GetClaim(tokenString, testSecret, ClaimShortCodeKey)
+	shortCode, err := GetClaim[string](tokenString, testIssuer, ClaimShortCodeKey)
 	require.NoError(t, err)
 	require.Equal(t, testShortCode, shortCode)
 
+	role, err := GetClaim[string](tokenString, testIssuer, ClaimRoleKey)
+	require.NoError(t, err)
+	require.Equal(t, testRole, role)
+
+	issuedAt, err := GetClaim[float64](tokenString, testIssuer, ClaimIssuedAtKey)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.UnixMicro(int64(issuedAt*1e6)), time.Second)
+
 	// Token with incorrect secret
 	invalidSecret := "invalidsecret321"
-	tokenString, err = Generate(testUserName, testShortCode, testSecret)
+	tokenString, err = Generate(testUserName, testShortCode, testRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	_, err = GetClaim(tokenString, invalidSecret, ClaimUserNameKey)
+	_, err = GetClaim[string](tokenString, newTestIssuer(invalidSecret), ClaimUserNameKey)
 	require.ErrorIs(t, err, ErrInvalidToken)
 
 	// Token with missing claims
 	missingClaimsSecret := "missingclaimssecret"
-	tokenString, err = Generate(testUserName, testShortCode, missingClaimsSecret)
+	tokenString, err = Generate(testUserName, testShortCode, testRole, testExpirationTime, newTestIssuer(missingClaimsSecret))
+	require.NoError(t, err)
+
+	_, err = GetClaim[string](tokenString, newTestIssuer(missingClaimsSecret), "nonexistentclaim")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestValidateClaims(t *testing.T) {
+	keySet := token.NewHMACKeySet(testSecret)
+
+	// Token issued by another issuer
+	tokenString, err := Generate(testUserName, testShortCode, testRole, testExpirationTime, token.NewIssuer("other", testAudience, keySet))
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Token issued for another audience
+	tokenString, err = Generate(testUserName, testShortCode, testRole, testExpirationTime, token.NewIssuer(testIssuerName, "other", keySet))
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Token of another type signed with the same issuer
+	tokenString, err = testIssuer.Sign(testIssuer.NewClaims("user", testUserName, testExpirationTime))
 	require.NoError(t, err)
 
-	_, err = GetClaim(tokenString, missingClaimsSecret, "nonexistentclaim")
+	err = Validate(tokenString, testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
 }
 
 func TestInvalidToken(t *testing.T) {
 	// Invalid token format
-	err := Validate("123XDTOKEN", testSecret)
+	err := Validate("123XDTOKEN", testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
 }
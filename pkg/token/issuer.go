@@ -0,0 +1,141 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+const (
+	// ClaimIssuerKey is the key for the claim with the name of the issuer of the token.
+	ClaimIssuerKey = "iss"
+	// ClaimAudienceKey is the key for the claim with the audience the token is intended for.
+	ClaimAudienceKey = "aud"
+	// ClaimSubjectKey is the key for the claim with the subject of the token.
+	ClaimSubjectKey = "sub"
+	// ClaimIssuedAtKey is the key for issue time claim, in Unix seconds with microsecond precision.
+	ClaimIssuedAtKey = "iat"
+	// ClaimIDKey is the key for the claim with the unique identifier of the token.
+	ClaimIDKey = "jti"
+	// ClaimExpiresAtKey is the key for expiration time claim, in Unix seconds.
+	ClaimExpiresAtKey = "exp"
+	// ClaimTokenTypeKey is the key for the claim with the type of the token, which tells tokens of different types apart.
+	ClaimTokenTypeKey = "tokenType"
+)
+
+var (
+	// ErrInvalidToken is returned when the token is invalid.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrExpiredToken is returned when the token is expired.
+	ErrExpiredToken = errors.New("expired token")
+)
+
+// Issuer issues and validates JWT tokens of a single type, intended for a single audience.
+// Giving each token type its own audience, and possibly key set, keeps a token of one type from being accepted as a token of another type.
+type Issuer struct {
+	name     string
+	audience string
+	keySet   *KeySet
+}
+
+// NewIssuer creates a new Issuer with the provided name and audience, signing and validating tokens with the keySet.
+func NewIssuer(name, audience string, keySet *KeySet) *Issuer {
+	return &Issuer{
+		name:     name,
+		audience: audience,
+		keySet:   keySet,
+	}
+}
+
+// KeySet returns the key set the issuer signs and validates tokens with.
+func (i *Issuer) KeySet() *KeySet {
+	return i.keySet
+}
+
+// NewClaims returns the standard claims of a token of the type issued now for the subject, which expires after the expiration time.
+func (i *Issuer) NewClaims(tokenType, subject string, expirationTime time.Duration) jwt.MapClaims {
+	now := time.Now()
+
+	return jwt.MapClaims{
+		ClaimIssuerKey:   i.name,
+		ClaimAudienceKey: i.audience,
+		ClaimSubjectKey:  subject,
+		// Microsecond precision lets tokens issued right after a revocation be told apart from the revoked ones.
+		ClaimIssuedAtKey:  float64(now.UnixMicro()) / float64(time.Second/time.Microsecond),
+		ClaimIDKey:        uuid.New().String(),
+		ClaimExpiresAtKey: now.Add(expirationTime).Unix(),
+		ClaimTokenTypeKey: tokenType,
+	}
+}
+
+// Sign creates a JWT token with the provided claims, signed with the key set of the issuer.
+func (i *Issuer) Sign(claims jwt.MapClaims) (string, error) {
+	return NewWithClaims(&claims, i.keySet)
+}
+
+// ParseClaims parses and validates a JWT token string and returns its claims.
+// The token must carry all standard claims, have been issued by the issuer for its audience and be of the type.
+// It returns ErrExpiredToken if the token is valid, but expired, and ErrInvalidToken otherwise.
+func (i *Issuer) ParseClaims(tokenString, tokenType string) (jwt.MapClaims, error) {
+	token, err := Parse(tokenString, i.keySet)
+	if err != nil {
+		// The expiration is checked only once the signature has been verified.
+		var validationErr *jwt.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Errors == jwt.ValidationErrorExpired {
+			return nil, ErrExpiredToken
+		}
+
+		return nil, ErrInvalidToken
+	}
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	if issuer, ok := claims[ClaimIssuerKey].(string); !ok || issuer != i.name {
+		return nil, ErrInvalidToken
+	}
+	if !HasAudience(claims, i.audience) {
+		return nil, ErrInvalidToken
+	}
+	if claimTokenType, ok := claims[ClaimTokenTypeKey].(string); !ok || claimTokenType != tokenType {
+		return nil, ErrInvalidToken
+	}
+	if subject, ok := claims[ClaimSubjectKey].(string); !ok || subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if id, ok := claims[ClaimIDKey].(string); !ok || id == "" {
+		return nil, ErrInvalidToken
+	}
+	// Parse checks the issue and expiration times only if the token carries them.
+	if _, ok := claims[ClaimIssuedAtKey].(float64); !ok {
+		return nil, ErrInvalidToken
+	}
+	if _, ok := claims[ClaimExpiresAtKey].(float64); !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// HasAudience reports whether the audience claim of the claims, either a single audience or a list of them, contains the audience.
+func HasAudience(claims jwt.MapClaims, audience string) bool {
+	switch claim := claims[ClaimAudienceKey].(type) {
+	case string:
+		return claim == audience
+	case []any:
+		for _, claimAudience := range claim {
+			if claimAudience == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
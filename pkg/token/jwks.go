@@ -0,0 +1,156 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// JWK represents a public key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// N and E are the modulus and exponent of an RSA key.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of an Ed25519 key.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS represents a set of public keys in the JSON Web Key Set format.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWKS returns the public keys of the key sets, sorted by their IDs, so that others can verify the tokens signed with them.
+// The same key is included once, so key sets loaded from the same directory can be passed together. The shared secret is never included.
+// It returns ErrKeyIDConflict if the key sets contain different keys with the same ID, since verifiers could not tell them apart.
+func NewJWKS(keySets ...*KeySet) (*JWKS, error) {
+	jwks := &JWKS{
+		Keys: []JWK{},
+	}
+
+	included := make(map[string]JWK)
+	for _, ks := range keySets {
+		for _, key := range ks.keys {
+			jwk, ok := newJWK(key)
+			if !ok {
+				continue
+			}
+
+			if includedJWK, ok := included[key.id]; ok {
+				if includedJWK != jwk {
+					return nil, fmt.Errorf("%w: %s", ErrKeyIDConflict, key.id)
+				}
+				continue
+			}
+
+			included[key.id] = jwk
+			jwks.Keys = append(jwks.Keys, jwk)
+		}
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].KeyID < jwks.Keys[j].KeyID
+	})
+
+	return jwks, nil
+}
+
+// newJWK returns the public key in the JSON Web Key format. It reports false for the shared secret, which has no public key.
+func newJWK(k *key) (JWK, bool) {
+	jwk := JWK{
+		KeyID:     k.id,
+		Use:       "sig",
+		Algorithm: k.method.Alg(),
+	}
+
+	switch verifyKey := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(verifyKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(verifyKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(verifyKey)
+	default:
+		return JWK{}, false
+	}
+
+	return jwk, true
+}
+
+// ParseJWKS returns a KeySet that verifies tokens with the RSA and Ed25519 public keys of the JSON Web Key Set, published by another issuer.
+// Keys of other types, as well as keys not meant for signatures, are skipped. The key set cannot sign tokens.
+func ParseJWKS(jwks *JWKS) (*KeySet, error) {
+	keySet := &KeySet{
+		keys: make(map[string]*key, len(jwks.Keys)),
+	}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		jwkKey, err := parseJWK(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %s: %w", jwk.KeyID, err)
+		}
+		if jwkKey == nil {
+			continue
+		}
+
+		keySet.keys[jwkKey.id] = jwkKey
+	}
+	if len(keySet.keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return keySet, nil
+}
+
+// parseJWK returns the verifying key of the JSON Web Key. It returns nil if the key is of an unsupported type or algorithm.
+func parseJWK(jwk JWK) (*key, error) {
+	switch {
+	case jwk.KeyType == "RSA" && (jwk.Algorithm == "" || jwk.Algorithm == jwt.SigningMethodRS256.Alg()):
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent: %s", jwk.E)
+		}
+
+		return &key{
+			id:        jwk.KeyID,
+			method:    jwt.SigningMethodRS256,
+			verifyKey: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())},
+		}, nil
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519" && (jwk.Algorithm == "" || jwk.Algorithm == jwt.SigningMethodEdDSA.Alg()):
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key: %s", jwk.X)
+		}
+
+		return &key{
+			id:        jwk.KeyID,
+			method:    jwt.SigningMethodEdDSA,
+			verifyKey: ed25519.PublicKey(x),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,140 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// KeyFileExtension is the extension of the files the key set is loaded from.
+const KeyFileExtension = ".pem"
+
+var (
+	// ErrNoKeys is returned when the key directory contains no key files.
+	ErrNoKeys = errors.New("no keys found")
+	// ErrUnsupportedKey is returned when a key file contains a key other than an RSA or Ed25519 one.
+	ErrUnsupportedKey = errors.New("unsupported key")
+	// ErrSigningKeyNotFound is returned when the key set contains no private key with the signing key ID.
+	ErrSigningKeyNotFound = errors.New("signing key not found")
+	// ErrKeyIDConflict is returned when key sets published together contain different keys with the same ID.
+	ErrKeyIDConflict = errors.New("different keys with the same ID")
+)
+
+// key is a key signing or verifying JWT tokens with a signing method.
+type key struct {
+	id     string
+	method jwt.SigningMethod
+	// signKey is the private key or the shared secret. It is nil for public keys, which only verify tokens.
+	signKey any
+	// verifyKey is the public key or the shared secret.
+	verifyKey any
+}
+
+// KeySet holds the keys JWT tokens are verified with, one of which signs new tokens.
+// Keys other than the signing one keep verifying the tokens signed with them while the keys are rotated.
+type KeySet struct {
+	signingKey *key
+	keys       map[string]*key
+}
+
+// NewHMACKeySet creates a new KeySet signing and verifying tokens with HS256 and the shared secret.
+func NewHMACKeySet(secret string) *KeySet {
+	secretKey := &key{
+		method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+
+	return &KeySet{
+		signingKey: secretKey,
+		keys: map[string]*key{
+			secretKey.id: secretKey,
+		},
+	}
+}
+
+// LoadKeySet loads a KeySet from the PEM-encoded RSA and Ed25519 keys in the files with the .pem extension in the directory.
+// The ID of each key is the name of its file without the extension. Private keys sign tokens with RS256 or EdDSA, while public keys only verify them.
+// New tokens are signed with the private key with the signingKeyID.
+func LoadKeySet(dir, signingKeyID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+KeyFileExtension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key files: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("failed to load keys from %s: %w", dir, ErrNoKeys)
+	}
+
+	keySet := &KeySet{
+		keys: make(map[string]*key, len(paths)),
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
+
+		fileKey, err := parseKey(strings.TrimSuffix(filepath.Base(path), KeyFileExtension), data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+		}
+
+		keySet.keys[fileKey.id] = fileKey
+	}
+
+	signingKey, ok := keySet.keys[signingKeyID]
+	if !ok || signingKey.signKey == nil {
+		return nil, fmt.Errorf("failed to load keys from %s: %w: %s", dir, ErrSigningKeyNotFound, signingKeyID)
+	}
+	keySet.signingKey = signingKey
+
+	return keySet, nil
+}
+
+func parseKey(id string, data []byte) (*key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: PEM block type %s", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch parsed := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &key{id: id, method: jwt.SigningMethodRS256, signKey: parsed, verifyKey: &parsed.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &key{id: id, method: jwt.SigningMethodRS256, verifyKey: parsed}, nil
+	case ed25519.PrivateKey:
+		return &key{id: id, method: jwt.SigningMethodEdDSA, signKey: parsed, verifyKey: parsed.Public()}, nil
+	case ed25519.PublicKey:
+		return &key{id: id, method: jwt.SigningMethodEdDSA, verifyKey: parsed}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, parsed)
+	}
+}
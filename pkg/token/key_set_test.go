@@ -0,0 +1,175 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, dir, id, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, id+KeyFileExtension), data, 0o600))
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "rsa-old", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "ed-new", "PRIVATE KEY", der)
+
+	oldKeySet, err := LoadKeySet(dir, "rsa-old")
+	require.NoError(t, err)
+	newKeySet, err := LoadKeySet(dir, "ed-new")
+	require.NoError(t, err)
+
+	claims := &jwt.MapClaims{"userName": "MSSkowron"}
+
+	oldToken, err := NewWithClaims(claims, oldKeySet)
+	require.NoError(t, err)
+	newToken, err := NewWithClaims(claims, newKeySet)
+	require.NoError(t, err)
+
+	parsed, err := Parse(newToken, newKeySet)
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+	require.Equal(t, "ed-new", parsed.Header[HeaderKeyIDKey])
+	require.Equal(t, "EdDSA", parsed.Header["alg"])
+
+	// Tokens signed with the previous key keep validating after the rotation.
+	parsed, err = Parse(oldToken, newKeySet)
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+	require.Equal(t, "RS256", parsed.Header["alg"])
+
+	// Tokens signed with the shared secret or an unknown key are rejected.
+	secretToken, err := NewWithClaims(claims, NewHMACKeySet("secret"))
+	require.NoError(t, err)
+	_, err = Parse(secretToken, newKeySet)
+	require.Error(t, err)
+
+	_, err = LoadKeySet(dir, "missing")
+	require.ErrorIs(t, err, ErrSigningKeyNotFound)
+	_, err = LoadKeySet(t.TempDir(), "rsa-old")
+	require.ErrorIs(t, err, ErrNoKeys)
+}
+
+func TestLoadKeySetPublicKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "rsa", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	edPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(edPublicKey)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "ed", "PUBLIC KEY", der)
+
+	// Public keys only verify tokens, so they cannot sign them.
+	_, err = LoadKeySet(dir, "ed")
+	require.ErrorIs(t, err, ErrSigningKeyNotFound)
+
+	keySet, err := LoadKeySet(dir, "rsa")
+	require.NoError(t, err)
+
+	jwks, err := NewJWKS(keySet, keySet)
+	require.NoError(t, err)
+	require.Len(t, jwks.Keys, 2)
+
+	require.Equal(t, "ed", jwks.Keys[0].KeyID)
+	require.Equal(t, "OKP", jwks.Keys[0].KeyType)
+	require.Equal(t, "Ed25519", jwks.Keys[0].Curve)
+	require.Equal(t, "EdDSA", jwks.Keys[0].Algorithm)
+	require.NotEmpty(t, jwks.Keys[0].X)
+
+	require.Equal(t, "rsa", jwks.Keys[1].KeyID)
+	require.Equal(t, "RSA", jwks.Keys[1].KeyType)
+	require.Equal(t, "RS256", jwks.Keys[1].Algorithm)
+	require.Equal(t, "AQAB", jwks.Keys[1].E)
+	require.NotEmpty(t, jwks.Keys[1].N)
+
+	// The shared secret is never published.
+	jwks, err = NewJWKS(NewHMACKeySet("secret"))
+	require.NoError(t, err)
+	require.Empty(t, jwks.Keys)
+
+	// Another key with the same ID, e.g. loaded from the directory of the other token type, cannot be told apart.
+	otherDir := t.TempDir()
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKeyFile(t, otherDir, "rsa", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(otherRSAKey))
+
+	otherKeySet, err := LoadKeySet(otherDir, "rsa")
+	require.NoError(t, err)
+
+	_, err = NewJWKS(keySet, otherKeySet)
+	require.ErrorIs(t, err, ErrKeyIDConflict)
+}
+
+func TestParseJWKS(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "rsa", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	writeKeyFile(t, dir, "ed", "PRIVATE KEY", der)
+
+	rsaKeySet, err := LoadKeySet(dir, "rsa")
+	require.NoError(t, err)
+	edKeySet, err := LoadKeySet(dir, "ed")
+	require.NoError(t, err)
+
+	// Keys of unsupported types and encryption keys are skipped.
+	jwks, err := NewJWKS(rsaKeySet)
+	require.NoError(t, err)
+	jwks.Keys = append(jwks.Keys, JWK{KeyType: "EC", KeyID: "ec", Use: "sig", Algorithm: "ES256", Curve: "P-256"}, JWK{KeyType: "RSA", KeyID: "enc", Use: "enc", N: "AQAB", E: "AQAB"})
+
+	keySet, err := ParseJWKS(jwks)
+	require.NoError(t, err)
+
+	claims := &jwt.MapClaims{"userName": "MSSkowron"}
+
+	rsaToken, err := NewWithClaims(claims, rsaKeySet)
+	require.NoError(t, err)
+	parsed, err := Parse(rsaToken, keySet)
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+
+	edToken, err := NewWithClaims(claims, edKeySet)
+	require.NoError(t, err)
+	parsed, err = Parse(edToken, keySet)
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+
+	// A public key is never used as an HMAC secret.
+	rsaJWK := jwks.Keys[1]
+	rsaJWK.Algorithm = "HS256"
+	_, err = ParseJWKS(&JWKS{Keys: []JWK{rsaJWK}})
+	require.ErrorIs(t, err, ErrNoKeys)
+
+	_, err = ParseJWKS(&JWKS{Keys: []JWK{{KeyType: "EC", KeyID: "ec"}}})
+	require.ErrorIs(t, err, ErrNoKeys)
+	_, err = ParseJWKS(&JWKS{Keys: []JWK{{KeyType: "OKP", KeyID: "ed", Curve: "Ed25519", X: "invalid"}}})
+	require.Error(t, err)
+}
@@ -6,21 +6,37 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
-// DefaultSigningMethod is the default signing method for JWT tokens.
-var DefaultSigningMethod = jwt.SigningMethodHS256
+// HeaderKeyIDKey is the key of the JWT token header with the ID of the key the token has been signed with.
+const HeaderKeyIDKey = "kid"
 
-// NewWithClaims creates a JWT token with the provided claims and secret.
-func NewWithClaims(claims *jwt.MapClaims, secret string) (string, error) {
-	token := jwt.NewWithClaims(DefaultSigningMethod, claims)
-	return token.SignedString([]byte(secret))
+// NewWithClaims creates a JWT token with the provided claims, signed with the signing key of the key set.
+// Unless the signing key is the shared secret, the token header carries the ID of the key.
+func NewWithClaims(claims *jwt.MapClaims, keySet *KeySet) (string, error) {
+	key := keySet.signingKey
+
+	token := jwt.NewWithClaims(key.method, claims)
+	if key.id != "" {
+		token.Header[HeaderKeyIDKey] = key.id
+	}
+
+	return token.SignedString(key.signKey)
 }
 
-// Parse parses a JWT token string using the provided secret and returns the token.
-func Parse(tokenString, secret string) (*jwt.Token, error) {
+// Parse parses a JWT token string, verifying it with the key of the key set identified by the token header, and returns the token.
+func Parse(tokenString string, keySet *KeySet) (*jwt.Token, error) {
 	return jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-		if token.Method != DefaultSigningMethod {
+		// Tokens signed with the shared secret carry no key ID.
+		keyID, _ := token.Header[HeaderKeyIDKey].(string)
+
+		key, ok := keySet.keys[keyID]
+		if !ok {
+			return nil, fmt.Errorf("unknown key ID: %v", token.Header[HeaderKeyIDKey])
+		}
+		// Accepting only the signing method of the key prevents tokens signed with a public key used as an HMAC secret.
+		if token.Method != key.method {
 			return nil, fmt.Errorf("invalid signing method: %v", token.Header["alg"])
 		}
-		return []byte(secret), nil
+
+		return key.verifyKey, nil
 	})
 }
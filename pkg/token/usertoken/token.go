@@ -1,66 +1,50 @@
 package usertoken
 
 import (
-	"errors"
+	"strconv"
 	"time"
 
 	"github.com/MSSkowron/GRPCChatter/pkg/token"
-	"github.com/golang-jwt/jwt"
 )
 
+// TokenType is the type of user tokens, carried in the token type claim.
+const TokenType = "user"
+
 const (
-	// ClaimUserIDKey is the key for user ID claim.
-	ClaimUserIDKey = "id"
+	// ClaimUserIDKey is the key for user ID claim, which is the subject of the token.
+	ClaimUserIDKey = token.ClaimSubjectKey
 	// ClaimUserNameKey is the key for user name claim.
 	ClaimUserNameKey = "userName"
 	// ClaimUserRolle is the key for user role claim.
 	ClaimUserRoleKey = "role"
 	// ClaimExpiresAtKey is the key for expiration time claim.
-	ClaimExpiresAtKey = "expiresAt"
+	ClaimExpiresAtKey = token.ClaimExpiresAtKey
 )
 
 var (
 	// ErrInvalidToken is returned when the token is invalid.
-	ErrInvalidToken = errors.New("invalid token")
+	ErrInvalidToken = token.ErrInvalidToken
 	// ErrExpiredToken is returned when the token is expired.
-	ErrExpiredToken = errors.New("expired token")
+	ErrExpiredToken = token.ErrExpiredToken
 )
 
-// Generate generates a new JWT token with user ID, user name, user role and expiration time.
-func Generate(userID int, userName string, role string, expirationTime time.Duration, secret string) (string, error) {
-	expiration := time.Now().Add(expirationTime).Unix()
-	claims := &jwt.MapClaims{
-		ClaimUserIDKey:    userID,
-		ClaimUserNameKey:  userName,
-		ClaimUserRoleKey:  role,
-		ClaimExpiresAtKey: expiration,
-	}
+// Generate generates a new JWT token with the standard claims, user ID, user name, user role and expiration time, issued by the issuer.
+func Generate(userID int, userName string, role string, expirationTime time.Duration, issuer *token.Issuer) (string, error) {
+	claims := issuer.NewClaims(TokenType, strconv.Itoa(userID), expirationTime)
+	claims[ClaimUserNameKey] = userName
+	claims[ClaimUserRoleKey] = role
 
-	return token.NewWithClaims(claims, secret)
+	return issuer.Sign(claims)
 }
 
-// Validate validates the given JWT token.
-func Validate(tokenString, secret string) error {
-	token, err := token.Parse(tokenString, secret)
-	if err != nil || !token.Valid {
-		return ErrInvalidToken
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return ErrInvalidToken
-	}
-
-	expiresAt, ok := token.Claims.(jwt.MapClaims)[ClaimExpiresAtKey].(float64)
-	if !ok {
-		return ErrInvalidToken
+// Validate validates the given JWT token, which must be a user token issued by the issuer.
+func Validate(tokenString string, issuer *token.Issuer) error {
+	claims, err := issuer.ParseClaims(tokenString, TokenType)
+	if err != nil {
+		return err
 	}
 
-	if int64(expiresAt) < time.Now().Local().Unix() {
-		return ErrExpiredToken
-	}
-
-	if _, ok := claims[ClaimUserIDKey].(float64); !ok {
+	if _, err := strconv.Atoi(claims[ClaimUserIDKey].(string)); err != nil {
 		return ErrInvalidToken
 	}
 
@@ -80,21 +64,16 @@ type Claim interface {
 	string | float64
 }
 
-// GetClaim retrieves a claim value with the given key from the given JWT token.
-func GetClaim[T Claim](tokenString, secret, key string) (T, error) {
+// GetClaim retrieves a claim value with the given key from the given JWT token, which must be a user token issued by the issuer.
+func GetClaim[T Claim](tokenString string, issuer *token.Issuer, key string) (T, error) {
 	var value T
 
-	token, err := token.Parse(tokenString, secret)
-	if err != nil || !token.Valid {
-		return value, ErrInvalidToken
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return value, ErrInvalidToken
+	claims, err := issuer.ParseClaims(tokenString, TokenType)
+	if err != nil {
+		return value, err
 	}
 
-	value, ok = claims[key].(T)
+	value, ok := claims[key].(T)
 	if !ok {
 		return value, ErrInvalidToken
 	}
@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MSSkowron/GRPCChatter/pkg/token"
+	"github.com/MSSkowron/GRPCChatter/pkg/token/chattoken"
 	"github.com/stretchr/testify/require"
 )
 
 const (
+	testIssuerName     = "grpcchatter"
+	testAudience       = "grpcchatter-test"
 	testSecret         = "testsecret123"
 	testUserName       = "MSSkowron"
 	testUserRole       = "USER"
@@ -15,73 +19,111 @@ const (
 	testExpirationTime = time.Hour
 )
 
+var testIssuer = newTestIssuer(testSecret)
+
+func newTestIssuer(secret string) *token.Issuer {
+	return token.NewIssuer(testIssuerName, testAudience, token.NewHMACKeySet(secret))
+}
+
 func TestGenerate(t *testing.T) {
-	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testSecret)
+	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 	require.NotEmpty(t, tokenString)
 }
 
 func TestValidate(t *testing.T) {
 	// Valid token
-	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testSecret)
+	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	err = Validate(tokenString, testSecret)
+	err = Validate(tokenString, testIssuer)
 	require.NoError(t, err)
 
 	// Invalid token
-	err = Validate("invalidtoken", testSecret)
+	err = Validate("invalidtoken", testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
 
 	// Token with incorrect secret
 	invalidSecret := "invalidsecret321"
-	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, testSecret)
+	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	err = Validate(tokenString, invalidSecret)
+	err = Validate(tokenString, newTestIssuer(invalidSecret))
 	require.ErrorIs(t, err, ErrInvalidToken)
 }
 
 func TestGetClaim(t *testing.T) {
 	// Valid claim retrieval
-	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testSecret)
+	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	userID, err := GetClaim[float64](tokenString, testSecret, ClaimUserIDKey)
+	userID, err := GetClaim[string](tokenString, testIssuer, ClaimUserIDKey)
 	require.NoError(t, err)
-	require.Equal(t, testUserID, int(userID))
+	require.Equal(t, "1", userID)
 
-	userName, err := GetClaim[string](tokenString, testSecret, ClaimUserNameKey)
+	userName, err := GetClaim[string](tokenString, testIssuer, ClaimUserNameKey)
 	require.NoError(t, err)
 	require.Equal(t, testUserName, userName)
 
-	userRole, err := GetClaim[string](tokenString, testSecret, ClaimUserRoleKey)
+	userRole, err := GetClaim[string](tokenString, testIssuer, ClaimUserRoleKey)
 	require.NoError(t, err)
 	require.Equal(t, testUserRole, userRole)
 
-	expiresAt, err := GetClaim[float64](tokenString, testSecret, ClaimExpiresAtKey)
+	expiresAt, err := GetClaim[float64](tokenString, testIssuer, ClaimExpiresAtKey)
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, expiresAt, float64(0))
 
 	// Token with incorrect secret
 	invalidSecret := "invalidsecret321"
-	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, testSecret)
+	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	_, err = GetClaim[string](tokenString, invalidSecret, ClaimUserNameKey)
+	_, err = GetClaim[string](tokenString, newTestIssuer(invalidSecret), ClaimUserNameKey)
 	require.ErrorIs(t, err, ErrInvalidToken)
 
 	// Token with missing claims
 	missingClaimsSecret := "missingclaimssecret"
-	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, missingClaimsSecret)
+	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, newTestIssuer(missingClaimsSecret))
+	require.NoError(t, err)
+
+	_, err = GetClaim[string](tokenString, newTestIssuer(missingClaimsSecret), "nonexistentclaim")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestValidateClaims(t *testing.T) {
+	keySet := token.NewHMACKeySet(testSecret)
+
+	// Token issued by another issuer
+	tokenString, err := Generate(testUserID, testUserName, testUserRole, testExpirationTime, token.NewIssuer("other", testAudience, keySet))
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Token issued for another audience
+	tokenString, err = Generate(testUserID, testUserName, testUserRole, testExpirationTime, token.NewIssuer(testIssuerName, "other", keySet))
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Chat token signed with the same issuer
+	tokenString, err = chattoken.Generate(testUserName, "ABC123", "MEMBER", testExpirationTime, testIssuer)
 	require.NoError(t, err)
 
-	_, err = GetClaim[string](tokenString, missingClaimsSecret, "nonexistentclaim")
+	err = Validate(tokenString, testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// Expired token
+	tokenString, err = Generate(testUserID, testUserName, testUserRole, -time.Minute, testIssuer)
+	require.NoError(t, err)
+
+	err = Validate(tokenString, testIssuer)
+	require.ErrorIs(t, err, ErrExpiredToken)
 }
 
 func TestInvalidToken(t *testing.T) {
 	// Invalid token format
-	err := Validate("123XDTOKEN", testSecret)
+	err := Validate("123XDTOKEN", testIssuer)
 	require.ErrorIs(t, err, ErrInvalidToken)
 }
@@ -20,11 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GRPCChatter_CreateChatRoom_FullMethodName    = "/proto.GRPCChatter/CreateChatRoom"
-	GRPCChatter_DeleteChatRoom_FullMethodName    = "/proto.GRPCChatter/DeleteChatRoom"
-	GRPCChatter_JoinChatRoom_FullMethodName      = "/proto.GRPCChatter/JoinChatRoom"
-	GRPCChatter_ListChatRoomUsers_FullMethodName = "/proto.GRPCChatter/ListChatRoomUsers"
-	GRPCChatter_Chat_FullMethodName              = "/proto.GRPCChatter/Chat"
+	GRPCChatter_CreateChatRoom_FullMethodName            = "/proto.GRPCChatter/CreateChatRoom"
+	GRPCChatter_ListChatRooms_FullMethodName             = "/proto.GRPCChatter/ListChatRooms"
+	GRPCChatter_GetChatRoomInfo_FullMethodName           = "/proto.GRPCChatter/GetChatRoomInfo"
+	GRPCChatter_UpdateChatRoom_FullMethodName            = "/proto.GRPCChatter/UpdateChatRoom"
+	GRPCChatter_DeleteChatRoom_FullMethodName            = "/proto.GRPCChatter/DeleteChatRoom"
+	GRPCChatter_TransferChatRoomOwnership_FullMethodName = "/proto.GRPCChatter/TransferChatRoomOwnership"
+	GRPCChatter_KickUser_FullMethodName                  = "/proto.GRPCChatter/KickUser"
+	GRPCChatter_BanUser_FullMethodName                   = "/proto.GRPCChatter/BanUser"
+	GRPCChatter_UnbanUser_FullMethodName                 = "/proto.GRPCChatter/UnbanUser"
+	GRPCChatter_PromoteUser_FullMethodName               = "/proto.GRPCChatter/PromoteUser"
+	GRPCChatter_DemoteUser_FullMethodName                = "/proto.GRPCChatter/DemoteUser"
+	GRPCChatter_MuteUser_FullMethodName                  = "/proto.GRPCChatter/MuteUser"
+	GRPCChatter_UnmuteUser_FullMethodName                = "/proto.GRPCChatter/UnmuteUser"
+	GRPCChatter_JoinChatRoom_FullMethodName              = "/proto.GRPCChatter/JoinChatRoom"
+	GRPCChatter_RefreshChatToken_FullMethodName          = "/proto.GRPCChatter/RefreshChatToken"
+	GRPCChatter_ListChatRoomUsers_FullMethodName         = "/proto.GRPCChatter/ListChatRoomUsers"
+	GRPCChatter_GetChatHistory_FullMethodName            = "/proto.GRPCChatter/GetChatHistory"
+	GRPCChatter_EditMessage_FullMethodName               = "/proto.GRPCChatter/EditMessage"
+	GRPCChatter_DeleteMessage_FullMethodName             = "/proto.GRPCChatter/DeleteMessage"
+	GRPCChatter_GetUnreadCount_FullMethodName            = "/proto.GRPCChatter/GetUnreadCount"
+	GRPCChatter_Chat_FullMethodName                      = "/proto.GRPCChatter/Chat"
 )
 
 // GRPCChatterClient is the client API for GRPCChatter service.
@@ -32,9 +48,25 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GRPCChatterClient interface {
 	CreateChatRoom(ctx context.Context, in *CreateChatRoomRequest, opts ...grpc.CallOption) (*CreateChatRoomResponse, error)
+	ListChatRooms(ctx context.Context, in *ListChatRoomsRequest, opts ...grpc.CallOption) (*ListChatRoomsResponse, error)
+	GetChatRoomInfo(ctx context.Context, in *GetChatRoomInfoRequest, opts ...grpc.CallOption) (*GetChatRoomInfoResponse, error)
+	UpdateChatRoom(ctx context.Context, in *UpdateChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteChatRoom(ctx context.Context, in *DeleteChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferChatRoomOwnership(ctx context.Context, in *TransferChatRoomOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PromoteUser(ctx context.Context, in *PromoteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DemoteUser(ctx context.Context, in *DemoteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	JoinChatRoom(ctx context.Context, in *JoinChatRoomRequest, opts ...grpc.CallOption) (*JoinChatRoomResponse, error)
+	RefreshChatToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RefreshChatTokenResponse, error)
 	ListChatRoomUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListChatRoomUsersResponse, error)
+	GetChatHistory(ctx context.Context, in *GetChatHistoryRequest, opts ...grpc.CallOption) (*GetChatHistoryResponse, error)
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUnreadCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
 	Chat(ctx context.Context, opts ...grpc.CallOption) (GRPCChatter_ChatClient, error)
 }
 
@@ -55,6 +87,33 @@ func (c *gRPCChatterClient) CreateChatRoom(ctx context.Context, in *CreateChatRo
 	return out, nil
 }
 
+func (c *gRPCChatterClient) ListChatRooms(ctx context.Context, in *ListChatRoomsRequest, opts ...grpc.CallOption) (*ListChatRoomsResponse, error) {
+	out := new(ListChatRoomsResponse)
+	err := c.cc.Invoke(ctx, GRPCChatter_ListChatRooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) GetChatRoomInfo(ctx context.Context, in *GetChatRoomInfoRequest, opts ...grpc.CallOption) (*GetChatRoomInfoResponse, error) {
+	out := new(GetChatRoomInfoResponse)
+	err := c.cc.Invoke(ctx, GRPCChatter_GetChatRoomInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) UpdateChatRoom(ctx context.Context, in *UpdateChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_UpdateChatRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gRPCChatterClient) DeleteChatRoom(ctx context.Context, in *DeleteChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, GRPCChatter_DeleteChatRoom_FullMethodName, in, out, opts...)
@@ -64,6 +123,78 @@ func (c *gRPCChatterClient) DeleteChatRoom(ctx context.Context, in *DeleteChatRo
 	return out, nil
 }
 
+func (c *gRPCChatterClient) TransferChatRoomOwnership(ctx context.Context, in *TransferChatRoomOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_TransferChatRoomOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_KickUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_BanUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_UnbanUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) PromoteUser(ctx context.Context, in *PromoteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_PromoteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) DemoteUser(ctx context.Context, in *DemoteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_DemoteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_MuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_UnmuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gRPCChatterClient) JoinChatRoom(ctx context.Context, in *JoinChatRoomRequest, opts ...grpc.CallOption) (*JoinChatRoomResponse, error) {
 	out := new(JoinChatRoomResponse)
 	err := c.cc.Invoke(ctx, GRPCChatter_JoinChatRoom_FullMethodName, in, out, opts...)
@@ -73,6 +204,15 @@ func (c *gRPCChatterClient) JoinChatRoom(ctx context.Context, in *JoinChatRoomRe
 	return out, nil
 }
 
+func (c *gRPCChatterClient) RefreshChatToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RefreshChatTokenResponse, error) {
+	out := new(RefreshChatTokenResponse)
+	err := c.cc.Invoke(ctx, GRPCChatter_RefreshChatToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gRPCChatterClient) ListChatRoomUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListChatRoomUsersResponse, error) {
 	out := new(ListChatRoomUsersResponse)
 	err := c.cc.Invoke(ctx, GRPCChatter_ListChatRoomUsers_FullMethodName, in, out, opts...)
@@ -82,6 +222,42 @@ func (c *gRPCChatterClient) ListChatRoomUsers(ctx context.Context, in *emptypb.E
 	return out, nil
 }
 
+func (c *gRPCChatterClient) GetChatHistory(ctx context.Context, in *GetChatHistoryRequest, opts ...grpc.CallOption) (*GetChatHistoryResponse, error) {
+	out := new(GetChatHistoryResponse)
+	err := c.cc.Invoke(ctx, GRPCChatter_GetChatHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_EditMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatter_DeleteMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterClient) GetUnreadCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, GRPCChatter_GetUnreadCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gRPCChatterClient) Chat(ctx context.Context, opts ...grpc.CallOption) (GRPCChatter_ChatClient, error) {
 	stream, err := c.cc.NewStream(ctx, &GRPCChatter_ServiceDesc.Streams[0], GRPCChatter_Chat_FullMethodName, opts...)
 	if err != nil {
@@ -118,9 +294,25 @@ func (x *gRPCChatterChatClient) Recv() (*ServerMessage, error) {
 // for forward compatibility
 type GRPCChatterServer interface {
 	CreateChatRoom(context.Context, *CreateChatRoomRequest) (*CreateChatRoomResponse, error)
+	ListChatRooms(context.Context, *ListChatRoomsRequest) (*ListChatRoomsResponse, error)
+	GetChatRoomInfo(context.Context, *GetChatRoomInfoRequest) (*GetChatRoomInfoResponse, error)
+	UpdateChatRoom(context.Context, *UpdateChatRoomRequest) (*emptypb.Empty, error)
 	DeleteChatRoom(context.Context, *DeleteChatRoomRequest) (*emptypb.Empty, error)
+	TransferChatRoomOwnership(context.Context, *TransferChatRoomOwnershipRequest) (*emptypb.Empty, error)
+	KickUser(context.Context, *KickUserRequest) (*emptypb.Empty, error)
+	BanUser(context.Context, *BanUserRequest) (*emptypb.Empty, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error)
+	PromoteUser(context.Context, *PromoteUserRequest) (*emptypb.Empty, error)
+	DemoteUser(context.Context, *DemoteUserRequest) (*emptypb.Empty, error)
+	MuteUser(context.Context, *MuteUserRequest) (*emptypb.Empty, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*emptypb.Empty, error)
 	JoinChatRoom(context.Context, *JoinChatRoomRequest) (*JoinChatRoomResponse, error)
+	RefreshChatToken(context.Context, *emptypb.Empty) (*RefreshChatTokenResponse, error)
 	ListChatRoomUsers(context.Context, *emptypb.Empty) (*ListChatRoomUsersResponse, error)
+	GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error)
+	EditMessage(context.Context, *EditMessageRequest) (*emptypb.Empty, error)
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*emptypb.Empty, error)
+	GetUnreadCount(context.Context, *emptypb.Empty) (*GetUnreadCountResponse, error)
 	Chat(GRPCChatter_ChatServer) error
 }
 
@@ -131,15 +323,63 @@ type UnimplementedGRPCChatterServer struct {
 func (UnimplementedGRPCChatterServer) CreateChatRoom(context.Context, *CreateChatRoomRequest) (*CreateChatRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateChatRoom not implemented")
 }
+func (UnimplementedGRPCChatterServer) ListChatRooms(context.Context, *ListChatRoomsRequest) (*ListChatRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChatRooms not implemented")
+}
+func (UnimplementedGRPCChatterServer) GetChatRoomInfo(context.Context, *GetChatRoomInfoRequest) (*GetChatRoomInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatRoomInfo not implemented")
+}
+func (UnimplementedGRPCChatterServer) UpdateChatRoom(context.Context, *UpdateChatRoomRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatRoom not implemented")
+}
 func (UnimplementedGRPCChatterServer) DeleteChatRoom(context.Context, *DeleteChatRoomRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChatRoom not implemented")
 }
+func (UnimplementedGRPCChatterServer) TransferChatRoomOwnership(context.Context, *TransferChatRoomOwnershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferChatRoomOwnership not implemented")
+}
+func (UnimplementedGRPCChatterServer) KickUser(context.Context, *KickUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) BanUser(context.Context, *BanUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) PromoteUser(context.Context, *PromoteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) DemoteUser(context.Context, *DemoteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) MuteUser(context.Context, *MuteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedGRPCChatterServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
 func (UnimplementedGRPCChatterServer) JoinChatRoom(context.Context, *JoinChatRoomRequest) (*JoinChatRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinChatRoom not implemented")
 }
+func (UnimplementedGRPCChatterServer) RefreshChatToken(context.Context, *emptypb.Empty) (*RefreshChatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshChatToken not implemented")
+}
 func (UnimplementedGRPCChatterServer) ListChatRoomUsers(context.Context, *emptypb.Empty) (*ListChatRoomUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChatRoomUsers not implemented")
 }
+func (UnimplementedGRPCChatterServer) GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatHistory not implemented")
+}
+func (UnimplementedGRPCChatterServer) EditMessage(context.Context, *EditMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedGRPCChatterServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedGRPCChatterServer) GetUnreadCount(context.Context, *emptypb.Empty) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
 func (UnimplementedGRPCChatterServer) Chat(GRPCChatter_ChatServer) error {
 	return status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
@@ -173,6 +413,60 @@ func _GRPCChatter_CreateChatRoom_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GRPCChatter_ListChatRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).ListChatRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_ListChatRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).ListChatRooms(ctx, req.(*ListChatRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_GetChatRoomInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatRoomInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).GetChatRoomInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_GetChatRoomInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).GetChatRoomInfo(ctx, req.(*GetChatRoomInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_UpdateChatRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).UpdateChatRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_UpdateChatRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).UpdateChatRoom(ctx, req.(*UpdateChatRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GRPCChatter_DeleteChatRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteChatRoomRequest)
 	if err := dec(in); err != nil {
@@ -191,6 +485,150 @@ func _GRPCChatter_DeleteChatRoom_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GRPCChatter_TransferChatRoomOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferChatRoomOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).TransferChatRoomOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_TransferChatRoomOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).TransferChatRoomOwnership(ctx, req.(*TransferChatRoomOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_KickUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).KickUser(ctx, req.(*KickUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_UnbanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_PromoteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).PromoteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_PromoteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).PromoteUser(ctx, req.(*PromoteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_DemoteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).DemoteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_DemoteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).DemoteUser(ctx, req.(*DemoteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).MuteUser(ctx, req.(*MuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GRPCChatter_JoinChatRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinChatRoomRequest)
 	if err := dec(in); err != nil {
@@ -209,6 +647,24 @@ func _GRPCChatter_JoinChatRoom_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GRPCChatter_RefreshChatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).RefreshChatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_RefreshChatToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).RefreshChatToken(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GRPCChatter_ListChatRoomUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -227,6 +683,78 @@ func _GRPCChatter_ListChatRoomUsers_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GRPCChatter_GetChatHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).GetChatHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_GetChatHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).GetChatHistory(ctx, req.(*GetChatHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatter_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatter_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterServer).GetUnreadCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GRPCChatter_Chat_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(GRPCChatterServer).Chat(&gRPCChatterChatServer{stream})
 }
@@ -264,18 +792,82 @@ var GRPCChatter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateChatRoom",
 			Handler:    _GRPCChatter_CreateChatRoom_Handler,
 		},
+		{
+			MethodName: "ListChatRooms",
+			Handler:    _GRPCChatter_ListChatRooms_Handler,
+		},
+		{
+			MethodName: "GetChatRoomInfo",
+			Handler:    _GRPCChatter_GetChatRoomInfo_Handler,
+		},
+		{
+			MethodName: "UpdateChatRoom",
+			Handler:    _GRPCChatter_UpdateChatRoom_Handler,
+		},
 		{
 			MethodName: "DeleteChatRoom",
 			Handler:    _GRPCChatter_DeleteChatRoom_Handler,
 		},
+		{
+			MethodName: "TransferChatRoomOwnership",
+			Handler:    _GRPCChatter_TransferChatRoomOwnership_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _GRPCChatter_KickUser_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _GRPCChatter_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _GRPCChatter_UnbanUser_Handler,
+		},
+		{
+			MethodName: "PromoteUser",
+			Handler:    _GRPCChatter_PromoteUser_Handler,
+		},
+		{
+			MethodName: "DemoteUser",
+			Handler:    _GRPCChatter_DemoteUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _GRPCChatter_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _GRPCChatter_UnmuteUser_Handler,
+		},
 		{
 			MethodName: "JoinChatRoom",
 			Handler:    _GRPCChatter_JoinChatRoom_Handler,
 		},
+		{
+			MethodName: "RefreshChatToken",
+			Handler:    _GRPCChatter_RefreshChatToken_Handler,
+		},
 		{
 			MethodName: "ListChatRoomUsers",
 			Handler:    _GRPCChatter_ListChatRoomUsers_Handler,
 		},
+		{
+			MethodName: "GetChatHistory",
+			Handler:    _GRPCChatter_GetChatHistory_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _GRPCChatter_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _GRPCChatter_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _GRPCChatter_GetUnreadCount_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -287,3 +879,202 @@ var GRPCChatter_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "proto/grpcchatter.proto",
 }
+
+const (
+	GRPCChatterAdmin_ListAllChatRooms_FullMethodName      = "/proto.GRPCChatterAdmin/ListAllChatRooms"
+	GRPCChatterAdmin_ForceDeleteChatRoom_FullMethodName   = "/proto.GRPCChatterAdmin/ForceDeleteChatRoom"
+	GRPCChatterAdmin_ListConnectedUsers_FullMethodName    = "/proto.GRPCChatterAdmin/ListConnectedUsers"
+	GRPCChatterAdmin_BroadcastAnnouncement_FullMethodName = "/proto.GRPCChatterAdmin/BroadcastAnnouncement"
+)
+
+// GRPCChatterAdminClient is the client API for GRPCChatterAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GRPCChatterAdminClient interface {
+	ListAllChatRooms(ctx context.Context, in *ListChatRoomsRequest, opts ...grpc.CallOption) (*ListChatRoomsResponse, error)
+	ForceDeleteChatRoom(ctx context.Context, in *DeleteChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListConnectedUsers(ctx context.Context, in *ListConnectedUsersRequest, opts ...grpc.CallOption) (*ListChatRoomUsersResponse, error)
+	BroadcastAnnouncement(ctx context.Context, in *BroadcastAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gRPCChatterAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCChatterAdminClient(cc grpc.ClientConnInterface) GRPCChatterAdminClient {
+	return &gRPCChatterAdminClient{cc}
+}
+
+func (c *gRPCChatterAdminClient) ListAllChatRooms(ctx context.Context, in *ListChatRoomsRequest, opts ...grpc.CallOption) (*ListChatRoomsResponse, error) {
+	out := new(ListChatRoomsResponse)
+	err := c.cc.Invoke(ctx, GRPCChatterAdmin_ListAllChatRooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterAdminClient) ForceDeleteChatRoom(ctx context.Context, in *DeleteChatRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatterAdmin_ForceDeleteChatRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterAdminClient) ListConnectedUsers(ctx context.Context, in *ListConnectedUsersRequest, opts ...grpc.CallOption) (*ListChatRoomUsersResponse, error) {
+	out := new(ListChatRoomUsersResponse)
+	err := c.cc.Invoke(ctx, GRPCChatterAdmin_ListConnectedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCChatterAdminClient) BroadcastAnnouncement(ctx context.Context, in *BroadcastAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCChatterAdmin_BroadcastAnnouncement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCChatterAdminServer is the server API for GRPCChatterAdmin service.
+// All implementations should embed UnimplementedGRPCChatterAdminServer
+// for forward compatibility
+type GRPCChatterAdminServer interface {
+	ListAllChatRooms(context.Context, *ListChatRoomsRequest) (*ListChatRoomsResponse, error)
+	ForceDeleteChatRoom(context.Context, *DeleteChatRoomRequest) (*emptypb.Empty, error)
+	ListConnectedUsers(context.Context, *ListConnectedUsersRequest) (*ListChatRoomUsersResponse, error)
+	BroadcastAnnouncement(context.Context, *BroadcastAnnouncementRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedGRPCChatterAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedGRPCChatterAdminServer struct {
+}
+
+func (UnimplementedGRPCChatterAdminServer) ListAllChatRooms(context.Context, *ListChatRoomsRequest) (*ListChatRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllChatRooms not implemented")
+}
+func (UnimplementedGRPCChatterAdminServer) ForceDeleteChatRoom(context.Context, *DeleteChatRoomRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeleteChatRoom not implemented")
+}
+func (UnimplementedGRPCChatterAdminServer) ListConnectedUsers(context.Context, *ListConnectedUsersRequest) (*ListChatRoomUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectedUsers not implemented")
+}
+func (UnimplementedGRPCChatterAdminServer) BroadcastAnnouncement(context.Context, *BroadcastAnnouncementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAnnouncement not implemented")
+}
+
+// UnsafeGRPCChatterAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCChatterAdminServer will
+// result in compilation errors.
+type UnsafeGRPCChatterAdminServer interface {
+	mustEmbedUnimplementedGRPCChatterAdminServer()
+}
+
+func RegisterGRPCChatterAdminServer(s grpc.ServiceRegistrar, srv GRPCChatterAdminServer) {
+	s.RegisterService(&GRPCChatterAdmin_ServiceDesc, srv)
+}
+
+func _GRPCChatterAdmin_ListAllChatRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterAdminServer).ListAllChatRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatterAdmin_ListAllChatRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterAdminServer).ListAllChatRooms(ctx, req.(*ListChatRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatterAdmin_ForceDeleteChatRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChatRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterAdminServer).ForceDeleteChatRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatterAdmin_ForceDeleteChatRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterAdminServer).ForceDeleteChatRoom(ctx, req.(*DeleteChatRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatterAdmin_ListConnectedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterAdminServer).ListConnectedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatterAdmin_ListConnectedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterAdminServer).ListConnectedUsers(ctx, req.(*ListConnectedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCChatterAdmin_BroadcastAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCChatterAdminServer).BroadcastAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCChatterAdmin_BroadcastAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCChatterAdminServer).BroadcastAnnouncement(ctx, req.(*BroadcastAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCChatterAdmin_ServiceDesc is the grpc.ServiceDesc for GRPCChatterAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCChatterAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.GRPCChatterAdmin",
+	HandlerType: (*GRPCChatterAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAllChatRooms",
+			Handler:    _GRPCChatterAdmin_ListAllChatRooms_Handler,
+		},
+		{
+			MethodName: "ForceDeleteChatRoom",
+			Handler:    _GRPCChatterAdmin_ForceDeleteChatRoom_Handler,
+		},
+		{
+			MethodName: "ListConnectedUsers",
+			Handler:    _GRPCChatterAdmin_ListConnectedUsers_Handler,
+		},
+		{
+			MethodName: "BroadcastAnnouncement",
+			Handler:    _GRPCChatterAdmin_BroadcastAnnouncement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/grpcchatter.proto",
+}